@@ -0,0 +1,85 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func accountDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "The account the provider's IAM token belongs to, with its resource quotas and current usage.  Quotas and usage are keyed by instances, vcpus, ram (GB), clusters, apps and models.  More information at https://www.entrywan.com/docs",
+		ReadContext: dataSourceAccountRead,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The account owner's email address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"organization": {
+				Description: "The account's organization name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"quotas": {
+				Description: "The maximum amount of each resource the account may use.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"usage": {
+				Description: "The amount of each resource the account currently uses.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+type accountGetRes struct {
+	Id           string         `json:"id"`
+	Email        string         `json:"email"`
+	Organization string         `json:"organization"`
+	Quotas       map[string]int `json:"quotas"`
+	Usage        map[string]int `json:"usage"`
+}
+
+func dataSourceAccountRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/account", nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read account: %s", string(b))
+	}
+	var cr accountGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return diag.Errorf("error unmarshaling response: %v", err)
+	}
+	d.SetId(cr.Id)
+	d.Set("email", cr.Email)
+	d.Set("organization", cr.Organization)
+	d.Set("quotas", cr.Quotas)
+	d.Set("usage", cr.Usage)
+	return nil
+}
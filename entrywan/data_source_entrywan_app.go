@@ -0,0 +1,124 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func appDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing PaaS application by name or ID.  More information at https://www.entrywan.com/docs#apps",
+		ReadContext: dataSourceAppRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the app to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the app to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"location": {
+				Description: "The physical data center the app operates in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "App state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The URL the app is reachable at.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"source": {
+				Description: "Type of app, either github or oci.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"image": {
+				Description: "For OCI-based apps, the image repository location.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"deployed_commit": {
+				Description: "For repo-based apps, the commit SHA currently deployed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// getApps fetches every PaaS application in the account.
+func getApps() ([]appGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/app", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list apps: %s", string(b))
+	}
+	var apps []appGetRes
+	err = json.Unmarshal(b, &apps)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return apps, nil
+}
+
+func dataSourceAppRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	apps, err := getApps()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []appGetRes
+	for _, a := range apps {
+		if (id != "" && a.Id == id) || (name != "" && a.Name == name) {
+			matches = append(matches, a)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no app found matching id %q name %q", id, name)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d apps found matching name %q, expected one", len(matches), name)
+	}
+	a := matches[0]
+	d.SetId(a.Id)
+	d.Set("name", a.Name)
+	d.Set("location", a.Location)
+	d.Set("state", a.State)
+	d.Set("url", a.Url)
+	d.Set("source", a.Source)
+	d.Set("image", a.Image)
+	d.Set("deployed_commit", a.Commit)
+	return nil
+}
@@ -0,0 +1,124 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func clusterDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing Kubernetes cluster by name or ID.  More information at https://www.entrywan.com/docs#kubernetes",
+		ReadContext: dataSourceClusterRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the cluster to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the cluster to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"location": {
+				Description: "The physical data center the cluster operates in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state": {
+				Description: "Cluster state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Cluster version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"apiserver": {
+				Description: "Cluster API server IPv4 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cni": {
+				Description: "The networking plugin in use.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"size": {
+				Description: "The number of worker nodes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// getClusters fetches every Kubernetes cluster in the account.
+func getClusters() ([]clusterGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/cluster", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list clusters: %s", string(b))
+	}
+	var clusters []clusterGetRes
+	err = json.Unmarshal(b, &clusters)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return clusters, nil
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	clusters, err := getClusters()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []clusterGetRes
+	for _, c := range clusters {
+		if (id != "" && c.Id == id) || (name != "" && c.Name == name) {
+			matches = append(matches, c)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no cluster found matching id %q name %q", id, name)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d clusters found matching name %q, expected one", len(matches), name)
+	}
+	c := matches[0]
+	d.SetId(c.Id)
+	d.Set("name", c.Name)
+	d.Set("location", c.Location)
+	d.Set("state", c.State)
+	d.Set("version", c.Version)
+	d.Set("apiserver", c.Apiserver)
+	d.Set("cni", c.Cni)
+	d.Set("size", c.Size)
+	return nil
+}
@@ -0,0 +1,128 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func firewallDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing firewall by name or ID.  More information at https://www.entrywan.com/docs#firewall",
+		ReadContext: dataSourceFirewallRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the firewall to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the firewall to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"rules": {
+				Description: "The firewall's rules.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Description: "Port number.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"src": {
+							Description: "Source address of traffic, an IPv4 or IPv6 address or CIDR block.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"protocol": {
+							Description: "Traffic protocol, example: all, tcp, udp or icmp.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type firewallGetRes struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectId string `json:"projectid"`
+	Rules     []Rule `json:"rules"`
+}
+
+// getFirewalls fetches every firewall in the account.
+func getFirewalls() ([]firewallGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/firewall", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list firewalls: %s", string(b))
+	}
+	var firewalls []firewallGetRes
+	err = json.Unmarshal(b, &firewalls)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return firewalls, nil
+}
+
+func dataSourceFirewallRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	firewalls, err := getFirewalls()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []firewallGetRes
+	for _, f := range firewalls {
+		if (id != "" && f.Id == id) || (name != "" && f.Name == name) {
+			matches = append(matches, f)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no firewall found matching id %q name %q", id, name)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d firewalls found matching name %q, expected one", len(matches), name)
+	}
+	f := matches[0]
+	rules := make([]map[string]any, len(f.Rules))
+	for i, r := range f.Rules {
+		rules[i] = map[string]any{
+			"port":     r.Port,
+			"src":      r.Src,
+			"protocol": r.Protocol,
+		}
+	}
+	d.SetId(f.Id)
+	d.Set("name", f.Name)
+	d.Set("rules", rules)
+	return nil
+}
@@ -0,0 +1,155 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func imagesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Operating system images available for compute instances.  More information at https://www.entrywan.com/docs#instances",
+		ReadContext: dataSourceImagesRead,
+		Schema: map[string]*schema.Schema{
+			"distro": {
+				Description: "Only return images of this distribution, example: debian.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"architecture": {
+				Description: "Only return images for this CPU architecture, example: x86_64.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"images": {
+				Description: "The available images.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "Image identifier to use as an instance os, example: debian-12.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"distro": {
+							Description: "Distribution name, example: debian.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "Distribution version, example: 12.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"architecture": {
+							Description: "CPU architecture, example: x86_64.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"deprecation_date": {
+							Description: "Date after which the image can no longer be used for new instances, empty if not deprecated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type image struct {
+	Slug         string `json:"slug"`
+	Distro       string `json:"distro"`
+	Version      string `json:"version"`
+	Architecture string `json:"architecture"`
+	Deprecated   string `json:"deprecated"`
+}
+
+// getImages fetches the operating system images the API currently
+// offers.
+func getImages() ([]image, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/image", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list images: %s", string(b))
+	}
+	var images []image
+	err = json.Unmarshal(b, &images)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return images, nil
+}
+
+// validateImage checks the os attribute names either an image slug or
+// a distribution, which selects that distribution's latest image.
+func validateImage(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.HasChange("os") || !d.NewValueKnown("os") {
+		return nil
+	}
+	os := d.Get("os").(string)
+	if os == "" {
+		return nil
+	}
+	images, err := getImages()
+	if err != nil {
+		return err
+	}
+	slugs := make([]string, len(images))
+	for i, img := range images {
+		if img.Slug == os || img.Distro == os {
+			return nil
+		}
+		slugs[i] = img.Slug
+	}
+	return fmt.Errorf("unknown os image %q, expected one of: %s", os, strings.Join(slugs, ", "))
+}
+
+func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	distro := d.Get("distro").(string)
+	architecture := d.Get("architecture").(string)
+	images, err := getImages()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	imagesList := []map[string]any{}
+	for _, img := range images {
+		if distro != "" && img.Distro != distro {
+			continue
+		}
+		if architecture != "" && img.Architecture != architecture {
+			continue
+		}
+		imagesList = append(imagesList, map[string]any{
+			"slug":             img.Slug,
+			"distro":           img.Distro,
+			"version":          img.Version,
+			"architecture":     img.Architecture,
+			"deprecation_date": img.Deprecated,
+		})
+	}
+	d.SetId("images")
+	d.Set("images", imagesList)
+	return nil
+}
@@ -0,0 +1,185 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func instanceDataSource() *schema.Resource {
+	s := instanceDataSourceSchema()
+	s["id"] = &schema.Schema{
+		Description:  "The ID of the instance to look up.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "hostname"},
+	}
+	s["hostname"] = &schema.Schema{
+		Description:  "The hostname of the instance to look up.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "hostname"},
+	}
+	return &schema.Resource{
+		Description: "Look up an existing compute instance by ID or hostname.  More information at https://www.entrywan.com/docs#instances",
+		ReadContext: dataSourceInstanceRead,
+		Schema:      s,
+	}
+}
+
+// instanceDataSourceSchema returns the computed attributes describing an
+// instance, shared by the entrywan_instance and entrywan_instances data
+// sources.
+func instanceDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the instance.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"hostname": {
+			Description: "The instance's hostname.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"location": {
+			Description: "The physical data center the instance operates in.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state": {
+			Description: "Instance state.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ip4": {
+			Description: "Instance primary IPv4 address.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ip6": {
+			Description: "Instance primary IPv6 address.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ip4private": {
+			Description: "Private IPv4 addresses of the instance in its VPCs.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"cpus": {
+			Description: "Number of CPU cores.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"ram": {
+			Description: "Memory in GB.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"disk": {
+			Description: "Hard disk in GB.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"os": {
+			Description: "The operating system image.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tags": {
+			Description: "The instance's tags.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// flattenInstance converts an instance returned by the API into the
+// attributes of instanceDataSourceSchema.
+func flattenInstance(i instanceGetRes) map[string]any {
+	return map[string]any{
+		"id":         i.Id,
+		"hostname":   i.Hostname,
+		"location":   i.Location,
+		"state":      i.State,
+		"ip4":        i.Ip4,
+		"ip4private": i.Ip4Private,
+		"ip6":        i.Ip6,
+		"cpus":       i.Cpus,
+		"ram":        i.Ram,
+		"disk":       i.Disk,
+		"os":         i.Os,
+		"tags":       i.Tags,
+	}
+}
+
+// getInstances fetches every instance in the account.
+func getInstances() ([]instanceGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/instance", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list instances: %s", string(b))
+	}
+	var instances []instanceGetRes
+	err = json.Unmarshal(b, &instances)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return instances, nil
+}
+
+func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	hostname := d.Get("hostname").(string)
+	instances, err := getInstances()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []instanceGetRes
+	for _, i := range instances {
+		if (id != "" && i.Id == id) || (hostname != "" && i.Hostname == hostname) {
+			matches = append(matches, i)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no instance found matching id %q hostname %q", id, hostname)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d instances found matching hostname %q, expected one", len(matches), hostname)
+	}
+	d.SetId(matches[0].Id)
+	for k, v := range flattenInstance(matches[0]) {
+		if k == "id" {
+			continue
+		}
+		d.Set(k, v)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func instanceTypesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Valid compute instance shapes and their prices in each location.  More information at https://www.entrywan.com/docs#instances",
+		ReadContext: dataSourceInstanceTypesRead,
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Description: "Only return instance types offered in this location.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"instance_types": {
+				Description: "The available instance types.  Prices are in USD.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Description: "The location the instance type is offered in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cpus": {
+							Description: "Number of virtual CPUs.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"ram": {
+							Description: "Memory in GB.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"disk_min": {
+							Description: "Smallest disk size in GB.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"disk_max": {
+							Description: "Largest disk size in GB.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"price_hourly": {
+							Description: "Price per hour.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"price_monthly": {
+							Description: "Price per month.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type instanceType struct {
+	Location     string  `json:"location"`
+	Cpus         int     `json:"cpus"`
+	Ram          int     `json:"ram"`
+	DiskMin      int     `json:"diskmin"`
+	DiskMax      int     `json:"diskmax"`
+	PriceHourly  float64 `json:"pricehourly"`
+	PriceMonthly float64 `json:"pricemonthly"`
+}
+
+// getInstanceTypes fetches the instance shapes and prices the API
+// currently offers in every location.
+func getInstanceTypes() ([]instanceType, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/instancetype", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list instance types: %s", string(b))
+	}
+	var instanceTypes []instanceType
+	err = json.Unmarshal(b, &instanceTypes)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return instanceTypes, nil
+}
+
+// validateInstanceShape checks the requested cpus, ram and disk against
+// the instance types offered in the instance's location.
+func validateInstanceShape(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.HasChanges("location", "cpus", "ram", "disk") {
+		return nil
+	}
+	for _, k := range []string{"location", "cpus", "ram", "disk"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	location := d.Get("location").(string)
+	cpus := d.Get("cpus").(int)
+	ram := d.Get("ram").(int)
+	disk := d.Get("disk").(int)
+	instanceTypes, err := getInstanceTypes()
+	if err != nil {
+		return err
+	}
+	var shapes []string
+	for _, t := range instanceTypes {
+		if t.Location != location {
+			continue
+		}
+		if t.Cpus == cpus && t.Ram == ram && disk >= t.DiskMin && disk <= t.DiskMax {
+			return nil
+		}
+		shapes = append(shapes, fmt.Sprintf("%d cpus/%d GB ram/%d-%d GB disk", t.Cpus, t.Ram, t.DiskMin, t.DiskMax))
+	}
+	return fmt.Errorf("no instance type in %s with %d cpus, %d GB ram and %d GB disk, expected one of: %s", location, cpus, ram, disk, strings.Join(shapes, ", "))
+}
+
+func dataSourceInstanceTypesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	location := d.Get("location").(string)
+	instanceTypes, err := getInstanceTypes()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceTypesList := []map[string]any{}
+	for _, t := range instanceTypes {
+		if location != "" && t.Location != location {
+			continue
+		}
+		instanceTypesList = append(instanceTypesList, map[string]any{
+			"location":      t.Location,
+			"cpus":          t.Cpus,
+			"ram":           t.Ram,
+			"disk_min":      t.DiskMin,
+			"disk_max":      t.DiskMax,
+			"price_hourly":  t.PriceHourly,
+			"price_monthly": t.PriceMonthly,
+		})
+	}
+	d.SetId("instance_types")
+	d.Set("instance_types", instanceTypesList)
+	return nil
+}
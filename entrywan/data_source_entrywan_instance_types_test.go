@@ -0,0 +1,44 @@
+package entrywan
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateInstanceShape(t *testing.T) {
+	testServeJson(t, map[string]any{
+		"/instancetype": []instanceType{
+			{Location: "us1", Cpus: 1, Ram: 2, DiskMin: 10, DiskMax: 100},
+			{Location: "us1", Cpus: 2, Ram: 4, DiskMin: 20, DiskMax: 200},
+			{Location: "eu1", Cpus: 1, Ram: 2, DiskMin: 10, DiskMax: 50},
+		},
+	})
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {Type: schema.TypeString, Optional: true},
+			"cpus":     {Type: schema.TypeInt, Optional: true},
+			"ram":      {Type: schema.TypeInt, Optional: true},
+			"disk":     {Type: schema.TypeInt, Optional: true},
+		},
+		CustomizeDiff: validateInstanceShape,
+	}
+	cases := []struct {
+		name    string
+		raw     map[string]any
+		wantErr bool
+	}{
+		{"offered", map[string]any{"location": "us1", "cpus": 2, "ram": 4, "disk": 80}, false},
+		{"smallest disk", map[string]any{"location": "us1", "cpus": 1, "ram": 2, "disk": 10}, false},
+		{"disk too large for location", map[string]any{"location": "eu1", "cpus": 1, "ram": 2, "disk": 80}, true},
+		{"disk too small", map[string]any{"location": "us1", "cpus": 2, "ram": 4, "disk": 10}, true},
+		{"no such shape", map[string]any{"location": "us1", "cpus": 2, "ram": 2, "disk": 20}, true},
+		{"location not yet known", map[string]any{"location": testUnknownValue, "cpus": 2, "ram": 2, "disk": 20}, false},
+	}
+	for _, c := range cases {
+		err := testResourceDiff(r, nil, c.raw)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %t", c.name, err, c.wantErr)
+		}
+	}
+}
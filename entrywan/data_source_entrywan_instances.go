@@ -0,0 +1,85 @@
+package entrywan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func instancesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Compute instances in the account, optionally filtered.  More information at https://www.entrywan.com/docs#instances",
+		ReadContext: dataSourceInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Description: "Only return instances in this location.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"state": {
+				Description: "Only return instances in this state, example: running.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"hostname_prefix": {
+				Description: "Only return instances whose hostname begins with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tag": {
+				Description: "Only return instances carrying this tag.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"instances": {
+				Description: "The matching instances.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: instanceDataSourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	location := d.Get("location").(string)
+	state := d.Get("state").(string)
+	hostnamePrefix := d.Get("hostname_prefix").(string)
+	tag := d.Get("tag").(string)
+	instances, err := getInstances()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instancesList := []map[string]any{}
+	for _, i := range instances {
+		if location != "" && i.Location != location {
+			continue
+		}
+		if state != "" && i.State != state {
+			continue
+		}
+		if !strings.HasPrefix(i.Hostname, hostnamePrefix) {
+			continue
+		}
+		if tag != "" && !instanceHasTag(i, tag) {
+			continue
+		}
+		instancesList = append(instancesList, flattenInstance(i))
+	}
+	d.SetId("instances")
+	d.Set("instances", instancesList)
+	return nil
+}
+
+func instanceHasTag(i instanceGetRes, tag string) bool {
+	for _, t := range i.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func loadbalancerDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing load balancer by name or ID.  More information at https://www.entrywan.com/docs#loadbalancers",
+		ReadContext: dataSourceLoadbalancerRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the load balancer to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the load balancer to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"location": {
+				Description: "The physical data center the load balancer operates in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"algo": {
+				Description: "Load balancing algorithm.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"protocol": {
+				Description: "Traffic protocol.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ip": {
+				Description: "Load balancer primary IPv4 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ip6": {
+				Description: "Load balancer primary IPv6 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"listeners": {
+				Description: "The load balancer's listeners.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Description: "Listener port.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"targets": {
+							Description: "Backends traffic is forwarded to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Description: "Target port.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"ip": {
+										Description: "Target IP address.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getLoadbalancers fetches every load balancer in the account.
+func getLoadbalancers() ([]loadbalancerGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/loadbalancer", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list load balancers: %s", string(b))
+	}
+	var loadbalancers []loadbalancerGetRes
+	err = json.Unmarshal(b, &loadbalancers)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return loadbalancers, nil
+}
+
+func dataSourceLoadbalancerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	loadbalancers, err := getLoadbalancers()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []loadbalancerGetRes
+	for _, l := range loadbalancers {
+		if (id != "" && l.Id == id) || (name != "" && l.Name == name) {
+			matches = append(matches, l)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no load balancer found matching id %q name %q", id, name)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d load balancers found matching name %q, expected one", len(matches), name)
+	}
+	l := matches[0]
+	listeners := make([]map[string]any, len(l.Listeners))
+	for i, listener := range l.Listeners {
+		targets := make([]map[string]any, len(listener.Targets))
+		for j, target := range listener.Targets {
+			targets[j] = map[string]any{
+				"port": target.Port,
+				"ip":   target.Ip,
+			}
+		}
+		listeners[i] = map[string]any{
+			"port":    listener.Port,
+			"targets": targets,
+		}
+	}
+	d.SetId(l.Id)
+	d.Set("name", l.Name)
+	d.Set("location", l.Location)
+	d.Set("algo", l.Algo)
+	d.Set("protocol", l.Protocol)
+	d.Set("ip", l.Ip)
+	d.Set("ip6", l.Ip6)
+	d.Set("listeners", listeners)
+	return nil
+}
@@ -0,0 +1,137 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func locationsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data centers resources can be placed in, along with the services each one offers.  More information at https://www.entrywan.com/docs",
+		ReadContext: dataSourceLocationsRead,
+		Schema: map[string]*schema.Schema{
+			"locations": {
+				Description: "The available locations.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slug": {
+							Description: "Location identifier used by resources, example: us1.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"city": {
+							Description: "City the data center is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"country": {
+							Description: "Country the data center is in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"services": {
+							Description: "Services offered in the location, example: instances, clusters or apps.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type location struct {
+	Slug     string   `json:"slug"`
+	City     string   `json:"city"`
+	Country  string   `json:"country"`
+	Services []string `json:"services"`
+}
+
+// getLocations fetches the data centers the API currently offers.
+func getLocations() ([]location, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/location", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list locations: %s", string(b))
+	}
+	var locations []location
+	err = json.Unmarshal(b, &locations)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return locations, nil
+}
+
+// validateLocation returns a CustomizeDiff function that checks the
+// location attribute names a data center offering service, one of
+// instances, apps, models, clusters or loadbalancers.
+func validateLocation(service string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		if !d.HasChange("location") || !d.NewValueKnown("location") {
+			return nil
+		}
+		slug := d.Get("location").(string)
+		locations, err := getLocations()
+		if err != nil {
+			return err
+		}
+		var offering []string
+		for _, l := range locations {
+			for _, s := range l.Services {
+				if s != service {
+					continue
+				}
+				if l.Slug == slug {
+					return nil
+				}
+				offering = append(offering, l.Slug)
+			}
+		}
+		return fmt.Errorf("location %q does not offer %s, expected one of: %s", slug, service, strings.Join(offering, ", "))
+	}
+}
+
+func dataSourceLocationsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	locations, err := getLocations()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	locationsList := make([]map[string]any, len(locations))
+	for i, l := range locations {
+		locationsList[i] = map[string]any{
+			"slug":     l.Slug,
+			"city":     l.City,
+			"country":  l.Country,
+			"services": l.Services,
+		}
+	}
+	d.SetId("locations")
+	d.Set("locations", locationsList)
+	return nil
+}
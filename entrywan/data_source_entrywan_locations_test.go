@@ -0,0 +1,44 @@
+package entrywan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateLocation(t *testing.T) {
+	testServeJson(t, map[string]any{
+		"/location": []location{
+			{Slug: "us1", Services: []string{"instances", "apps"}},
+			{Slug: "eu1", Services: []string{"instances"}},
+		},
+	})
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {Type: schema.TypeString, Optional: true},
+		},
+		CustomizeDiff: validateLocation("apps"),
+	}
+	cases := []struct {
+		name    string
+		state   map[string]string
+		raw     map[string]any
+		wantErr string
+	}{
+		{"offered", nil, map[string]any{"location": "us1"}, ""},
+		{"not offered", nil, map[string]any{"location": "eu1"}, `location "eu1" does not offer apps, expected one of: us1`},
+		{"unknown location", nil, map[string]any{"location": "xx9"}, "does not offer apps"},
+		{"unchanged", map[string]string{"location": "eu1"}, map[string]any{"location": "eu1"}, ""},
+		{"not yet known", nil, map[string]any{"location": testUnknownValue}, ""},
+	}
+	for _, c := range cases {
+		err := testResourceDiff(r, c.state, c.raw)
+		if c.wantErr == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)) {
+			t.Errorf("%s: got error %v, want %q", c.name, err, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func modelTypesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "AI model types available for use with entrywan_model.  More information at https://www.entrywan.com/docs#models",
+		ReadContext: dataSourceModelTypesRead,
+		Schema: map[string]*schema.Schema{
+			"types": {
+				Description: "The available model types.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "Model type name, as passed to entrywan_model.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Model type description.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locations": {
+							Description: "Locations the model type is available in.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type modelType struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Locations   []string `json:"locations"`
+}
+
+// getModelTypes fetches the model types the API currently offers.
+func getModelTypes() ([]modelType, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/model/types", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list model types: %s", string(b))
+	}
+	var types []modelType
+	err = json.Unmarshal(b, &types)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return types, nil
+}
+
+func dataSourceModelTypesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	types, err := getModelTypes()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	typesList := make([]map[string]any, len(types))
+	for i, t := range types {
+		typesList[i] = map[string]any{
+			"name":        t.Name,
+			"description": t.Description,
+			"locations":   t.Locations,
+		}
+	}
+	d.SetId("model_types")
+	d.Set("types", typesList)
+	return nil
+}
@@ -0,0 +1,122 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func snapshotsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Instance snapshots in the account, newest first, so the latest match is always the first element.  More information at https://www.entrywan.com/docs#snapshots",
+		ReadContext: dataSourceSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Description: "Only return snapshots whose name begins with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location": {
+				Description: "Only return snapshots stored in this location.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"snapshots": {
+				Description: "The matching snapshots, newest first.  Only available snapshots are returned.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The snapshot ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The snapshot name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"instance_id": {
+							Description: "The ID of the instance the snapshot was taken from.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"location": {
+							Description: "The data center the snapshot is stored in.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"size": {
+							Description: "Snapshot size in GB.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"created": {
+							Description: "Time the snapshot was taken, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	namePrefix := d.Get("name_prefix").(string)
+	location := d.Get("location").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/snapshot", nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to list snapshots: %s", string(b))
+	}
+	var snapshots []snapshotGetRes
+	err = json.Unmarshal(b, &snapshots)
+	if err != nil {
+		return diag.Errorf("error unmarshaling response: %v", err)
+	}
+	// RFC 3339 timestamps in UTC sort lexically.
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Created > snapshots[j].Created
+	})
+	snapshotsList := []map[string]any{}
+	for _, s := range snapshots {
+		if s.State != "available" || !strings.HasPrefix(s.Name, namePrefix) {
+			continue
+		}
+		if location != "" && s.Location != location {
+			continue
+		}
+		snapshotsList = append(snapshotsList, map[string]any{
+			"id":          s.Id,
+			"name":        s.Name,
+			"instance_id": s.InstanceId,
+			"location":    s.Location,
+			"size":        s.Size,
+			"created":     s.Created,
+		})
+	}
+	d.SetId("snapshots")
+	d.Set("snapshots", snapshotsList)
+	return nil
+}
@@ -0,0 +1,144 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+func sshkeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing ssh key by name or fingerprint.  More information at https://www.entrywan.com/docs#ssh",
+		ReadContext: dataSourceSshkeyRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:  "The name of the key to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "fingerprint"},
+			},
+			"fingerprint": {
+				Description:  "The fingerprint of the key to look up, as printed by ssh-keygen -l (SHA256:...) or ssh-keygen -l -E md5 (MD5:aa:bb:...).  The SHA256: and MD5: prefixes are optional.  Set to the SHA256 fingerprint when looking up by name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "fingerprint"},
+			},
+			"pub": {
+				Description: "The public key in authorized_keys format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"algorithm": {
+				Description: "The key algorithm, one of rsa, dsa, ecdsa or ed25519.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fingerprint_sha256": {
+				Description: "The SHA256 fingerprint of the key, whichever form of fingerprint it was looked up by.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// sshkeyInfo is an ssh key as returned by the API along with the
+// attributes derived from its public key.
+type sshkeyInfo struct {
+	sshkeyGetRes
+	Algorithm      string
+	Fingerprint    string
+	FingerprintMd5 string
+}
+
+// getSshkeys fetches every ssh key in the account.
+func getSshkeys() ([]sshkeyInfo, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/sshkey", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list sshkeys: %s", string(b))
+	}
+	var keys []sshkeyGetRes
+	err = json.Unmarshal(b, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	infos := make([]sshkeyInfo, len(keys))
+	for i, k := range keys {
+		infos[i].sshkeyGetRes = k
+		key, algorithm, err := parseSshkeyPub(k.Pub)
+		if err != nil {
+			continue
+		}
+		infos[i].Algorithm = algorithm
+		infos[i].Fingerprint = ssh.FingerprintSHA256(key)
+		infos[i].FingerprintMd5 = ssh.FingerprintLegacyMD5(key)
+	}
+	return infos, nil
+}
+
+// sshkeyFingerprintMatches reports whether fingerprint is the SHA256 or
+// MD5 fingerprint of the key, with or without the SHA256: or MD5:
+// prefix printed by ssh-keygen.
+func sshkeyFingerprintMatches(k sshkeyInfo, fingerprint string) bool {
+	if strings.TrimPrefix(fingerprint, "SHA256:") == strings.TrimPrefix(k.Fingerprint, "SHA256:") {
+		return true
+	}
+	return strings.EqualFold(strings.TrimPrefix(fingerprint, "MD5:"), k.FingerprintMd5)
+}
+
+func dataSourceSshkeyRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	fingerprint := d.Get("fingerprint").(string)
+	keys, err := getSshkeys()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []sshkeyInfo
+	for _, k := range keys {
+		if name != "" && k.Name == name {
+			matches = append(matches, k)
+		}
+		if fingerprint != "" && sshkeyFingerprintMatches(k, fingerprint) {
+			matches = append(matches, k)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no sshkey found matching name %q fingerprint %q", name, fingerprint)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d sshkeys found matching name %q fingerprint %q, expected one", len(matches), name, fingerprint)
+	}
+	k := matches[0]
+	d.SetId(k.Id)
+	d.Set("name", k.Name)
+	d.Set("pub", k.Pub)
+	d.Set("algorithm", k.Algorithm)
+	if fingerprint == "" {
+		d.Set("fingerprint", k.Fingerprint)
+	}
+	d.Set("fingerprint_sha256", k.Fingerprint)
+	return nil
+}
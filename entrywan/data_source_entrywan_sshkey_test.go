@@ -0,0 +1,72 @@
+package entrywan
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSshkeyFingerprintMatches(t *testing.T) {
+	k := sshkeyInfo{
+		Fingerprint:    "SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s",
+		FingerprintMd5: "c1:b1:30:29:d7:b8:de:6c:97:77:10:d7:46:41:63:87",
+	}
+	cases := []struct {
+		fingerprint string
+		match       bool
+	}{
+		{"SHA256:uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s", true},
+		{"uNiVztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s", true},
+		{"MD5:c1:b1:30:29:d7:b8:de:6c:97:77:10:d7:46:41:63:87", true},
+		{"c1:b1:30:29:d7:b8:de:6c:97:77:10:d7:46:41:63:87", true},
+		{"C1:B1:30:29:D7:B8:DE:6C:97:77:10:D7:46:41:63:87", true},
+		{"SHA256:AAAAztksCsDhcc0u9e8BujQXVUpKZIDTMczCvj3tD2s", false},
+		{"MD5:00:b1:30:29:d7:b8:de:6c:97:77:10:d7:46:41:63:87", false},
+	}
+	for _, c := range cases {
+		if got := sshkeyFingerprintMatches(k, c.fingerprint); got != c.match {
+			t.Errorf("sshkeyFingerprintMatches(%q) = %t, want %t", c.fingerprint, got, c.match)
+		}
+	}
+}
+
+func TestDataSourceSshkeyRead(t *testing.T) {
+	key, _, _ := ed25519.GenerateKey(rand.Reader)
+	pub := testSshkeyPub(t, key, "me@host")
+	sshPub, _, _, _, _ := ssh.ParseAuthorizedKey([]byte(pub))
+	sha256 := ssh.FingerprintSHA256(sshPub)
+	md5 := ssh.FingerprintLegacyMD5(sshPub)
+	testServeJson(t, map[string]any{
+		"/sshkey": []sshkeyGetRes{{Id: "key1", Name: "alice", Pub: pub}},
+	})
+	cases := []struct {
+		name            string
+		raw             map[string]any
+		wantFingerprint string
+	}{
+		{"by name", map[string]any{"name": "alice"}, sha256},
+		{"by sha256", map[string]any{"fingerprint": sha256}, sha256},
+		{"by md5", map[string]any{"fingerprint": "MD5:" + md5}, "MD5:" + md5},
+		{"by bare md5", map[string]any{"fingerprint": md5}, md5},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, sshkeyDataSource().Schema, c.raw)
+		if diags := dataSourceSshkeyRead(context.Background(), d, nil); diags.HasError() {
+			t.Errorf("%s: unexpected error: %v", c.name, diags)
+			continue
+		}
+		if d.Id() != "key1" {
+			t.Errorf("%s: id = %q, want key1", c.name, d.Id())
+		}
+		if got := d.Get("fingerprint").(string); got != c.wantFingerprint {
+			t.Errorf("%s: fingerprint = %q, want %q", c.name, got, c.wantFingerprint)
+		}
+		if got := d.Get("fingerprint_sha256").(string); got != sha256 {
+			t.Errorf("%s: fingerprint_sha256 = %q, want %q", c.name, got, sha256)
+		}
+	}
+}
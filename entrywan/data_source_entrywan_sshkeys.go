@@ -0,0 +1,71 @@
+package entrywan
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func sshkeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "All ssh keys in the account.  More information at https://www.entrywan.com/docs#ssh",
+		ReadContext: dataSourceSshkeysRead,
+		Schema: map[string]*schema.Schema{
+			"sshkeys": {
+				Description: "The ssh keys.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ssh key ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The ssh key name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"pub": {
+							Description: "The public key in authorized_keys format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"algorithm": {
+							Description: "The key algorithm, one of rsa, dsa, ecdsa or ed25519.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"fingerprint": {
+							Description: "The SHA256 fingerprint of the key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSshkeysRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	keys, err := getSshkeys()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keysList := make([]map[string]any, len(keys))
+	for i, k := range keys {
+		keysList[i] = map[string]any{
+			"id":          k.Id,
+			"name":        k.Name,
+			"pub":         k.Pub,
+			"algorithm":   k.Algorithm,
+			"fingerprint": k.Fingerprint,
+		}
+	}
+	d.SetId("sshkeys")
+	d.Set("sshkeys", keysList)
+	return nil
+}
@@ -0,0 +1,127 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func vpcDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing VPC by name or ID.  More information at https://www.entrywan.com/docs#vpcnetworks",
+		ReadContext: dataSourceVpcRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The ID of the VPC to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The name of the VPC to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"prefix": {
+				Description: "The CIDR prefix of the network.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"prefix6": {
+				Description: "The IPv6 CIDR prefix of the network, if any.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"members": {
+				Description: "The members of the VPC.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip4public": {
+							Description: "Public IPv4 address of the member instance.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ip4private": {
+							Description: "Private IPv4 address of the member instance within the VPC.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getVpcs fetches every VPC in the account.
+func getVpcs() ([]vpcRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/vpc", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to list vpcs: %s", string(b))
+	}
+	var vpcs []vpcRes
+	err = json.Unmarshal(b, &vpcs)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return vpcs, nil
+}
+
+func dataSourceVpcRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+	vpcs, err := getVpcs()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var matches []vpcRes
+	for _, v := range vpcs {
+		if (id != "" && v.Id == id) || (name != "" && v.Name == name) {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 0 {
+		return diag.Errorf("no vpc found matching id %q name %q", id, name)
+	}
+	if len(matches) > 1 {
+		return diag.Errorf("%d vpcs found matching name %q, expected one", len(matches), name)
+	}
+	v := matches[0]
+	members := make([]map[string]any, len(v.Members))
+	for i, member := range v.Members {
+		members[i] = map[string]any{
+			"ip4public":  member.Ippublic,
+			"ip4private": member.Ipprivate,
+		}
+	}
+	d.SetId(v.Id)
+	d.Set("name", v.Name)
+	d.Set("prefix", v.Prefix)
+	d.Set("prefix6", v.Prefix6)
+	d.Set("members", members)
+	return nil
+}
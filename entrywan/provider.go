@@ -6,6 +6,7 @@ import (
 
 var token string
 var endpoint string
+var defaultProject string
 
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -23,16 +24,55 @@ func Provider() *schema.Provider {
 				Required:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ENTRYWAN_ENDPOINT", nil),
 			},
+			"default_project": {
+				Description: "ID of the project resources are created in when they do not set project_id",
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ENTRYWAN_PROJECT", nil),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"entrywan_instance":     instanceResource(),
-			"entrywan_sshkey":       sshkeyResource(),
-			"entrywan_cluster":      clusterResource(),
-			"entrywan_app":          appResource(),
-			"entrywan_model":        modelResource(),
-			"entrywan_firewall":     firewallResource(),
-			"entrywan_loadbalancer": loadbalancerResource(),
-			"entrywan_vpc":          vpcResource(),
+			"entrywan_instance":               instanceResource(),
+			"entrywan_sshkey":                 sshkeyResource(),
+			"entrywan_cluster":                clusterResource(),
+			"entrywan_app":                    appResource(),
+			"entrywan_app_domain":             appDomainResource(),
+			"entrywan_model":                  modelResource(),
+			"entrywan_firewall":               firewallResource(),
+			"entrywan_loadbalancer":           loadbalancerResource(),
+			"entrywan_vpc":                    vpcResource(),
+			"entrywan_volume":                 volumeResource(),
+			"entrywan_volume_attachment":      volumeAttachmentResource(),
+			"entrywan_reserved_ip":            reservedIpResource(),
+			"entrywan_reserved_ip_assignment": reservedIpAssignmentResource(),
+			"entrywan_snapshot":               snapshotResource(),
+			"entrywan_dns_zone":               dnsZoneResource(),
+			"entrywan_dns_record":             dnsRecordResource(),
+			"entrywan_reverse_dns":            reverseDnsResource(),
+			"entrywan_bucket":                 bucketResource(),
+			"entrywan_bucket_key":             bucketKeyResource(),
+			"entrywan_registry":               registryResource(),
+			"entrywan_iam_role":               iamRoleResource(),
+			"entrywan_iam_user":               iamUserResource(),
+			"entrywan_iam_token":              iamTokenResource(),
+			"entrywan_project":                projectResource(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"entrywan_account":        accountDataSource(),
+			"entrywan_app":            appDataSource(),
+			"entrywan_cluster":        clusterDataSource(),
+			"entrywan_firewall":       firewallDataSource(),
+			"entrywan_images":         imagesDataSource(),
+			"entrywan_instance":       instanceDataSource(),
+			"entrywan_instances":      instancesDataSource(),
+			"entrywan_instance_types": instanceTypesDataSource(),
+			"entrywan_loadbalancer":   loadbalancerDataSource(),
+			"entrywan_locations":      locationsDataSource(),
+			"entrywan_model_types":    modelTypesDataSource(),
+			"entrywan_snapshots":      snapshotsDataSource(),
+			"entrywan_sshkey":         sshkeyDataSource(),
+			"entrywan_sshkeys":        sshkeysDataSource(),
+			"entrywan_vpc":            vpcDataSource(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -44,5 +84,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	endpointVal := d.Get("endpoint").(string)
 	endpoint = endpointVal
+
+	defaultProject = d.Get("default_project").(string)
 	return nil, nil
 }
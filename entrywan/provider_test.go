@@ -0,0 +1,51 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testUnknownValue marks a configuration value as unknown until apply,
+// such as an attribute of a resource that is not yet created.
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testResourceDiff plans r from state to raw, running its CustomizeDiff
+// functions.  A nil state plans a new resource.
+func testResourceDiff(r *schema.Resource, state map[string]string, raw map[string]any) error {
+	var s *terraform.InstanceState
+	if state != nil {
+		s = &terraform.InstanceState{ID: "test", Attributes: state}
+	}
+	_, err := r.Diff(context.Background(), s, terraform.NewResourceConfigRaw(raw), nil)
+	return err
+}
+
+// testServeJson points the provider at a test server that responds to
+// each path with the JSON encoding of the given value.
+func testServeJson(t *testing.T, responses map[string]any) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(res)
+	}))
+	t.Cleanup(server.Close)
+	oldEndpoint := endpoint
+	endpoint = server.URL
+	t.Cleanup(func() { endpoint = oldEndpoint })
+}
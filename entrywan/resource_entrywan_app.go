@@ -7,11 +7,28 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// registryHostPattern matches a registry host with an optional port,
+// such as ghcr.io or localhost:5000, as it begins an OCI image reference.
+const registryHostPattern = `[a-z0-9]+(?:[._-][a-z0-9]+)*(?::[0-9]+)?`
+
+// registryHostRegexp matches a registry host on its own.
+var registryHostRegexp = regexp.MustCompile(`^` + registryHostPattern + `$`)
+
+// imageRefRegexp matches an OCI image reference such as nginx,
+// nginx:1.25, ghcr.io/org/app:v2 or registry:5000/app@sha256:<digest>.
+var imageRefRegexp = regexp.MustCompile(`^` + registryHostPattern + `(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*(?::[A-Za-z0-9_][A-Za-z0-9_.-]{0,127})?(?:@sha256:[a-f0-9]{64})?$`)
+
 func appResource() *schema.Resource {
 	return &schema.Resource{
 		Description:   "PaaS application.  Either repo- or OCI-based.  More information at https://www.entrywan.com/docs#apps",
@@ -19,6 +36,17 @@ func appResource() *schema.Resource {
 		ReadContext:   resourceAppRead,
 		UpdateContext: resourceAppUpdate,
 		DeleteContext: resourceAppDelete,
+		CustomizeDiff: customdiff.All(
+			resourceAppCustomizeDiff,
+			validateLocation("apps"),
+			customdiff.ComputedIf("deployed_commit", func(ctx context.Context, d *schema.ResourceDiff, m any) bool {
+				return d.HasChanges("commit", "redeploy_trigger")
+			}),
+		),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The subdomain the app listens on, example: myapp.entrywan.app.  Must be globally unique.",
@@ -30,6 +58,7 @@ func appResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"size": {
 				Description: "Amount of RAM in MB.",
 				Type:        schema.TypeInt,
@@ -45,40 +74,188 @@ func appResource() *schema.Resource {
 				Type:        schema.TypeInt,
 				Required:    true,
 			},
+			"replicas": {
+				Description:      "Number of app instances to run.  Can be scaled up or down as needed.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"running_replicas": {
+				Description: "Number of app instances currently running and healthy.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"healthcheck": {
+				Description: "HTTP health check used to restart unhealthy app instances and route traffic away from them.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Description:      "HTTP path to request, example: /healthz.  Any 2xx or 3xx response is considered healthy.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^/`), "must begin with /")),
+						},
+						"interval": {
+							Description:      "Seconds between health checks.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          10,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 300)),
+						},
+						"grace_period": {
+							Description:      "Seconds to wait after an app instance starts before health checks begin.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          30,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 3600)),
+						},
+					},
+				},
+			},
 			"source": {
-				Description: "Type of app to deploy, either github or oci.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Type of app to deploy, either github or oci.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"github", "oci"}, false)),
 			},
 			"repo": {
-				Description: "Required for repo-based apps, the repository URL.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "Required for repo-based apps, the repository URL.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"image", "repo"},
+				RequiredWith:     []string{"repobranch"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 			},
 			"repobranch": {
-				Description: "Required for repo-based apps, the repo branch name.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "Required for repo-based apps, the repo branch name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"repo"},
 			},
 			"reporoot": {
-				Description: "For repo-based apps, the optional directory root the app source begins at.",
-				Type:        schema.TypeString,
+				Description:  "For repo-based apps, the optional directory root the app source begins at.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"repo"},
+			},
+			"commit": {
+				Description:   "For repo-based apps, the commit SHA to deploy instead of the head of repobranch.  Changing it starts a new build.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"image"},
+			},
+			"redeploy_trigger": {
+				Description: "Arbitrary map of values that, when changed, starts a new build and deployment of the app.",
+				Type:        schema.TypeMap,
 				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
-			"credential": {
-				Description: "For repo-based apps hosted in private repositories, a personal access token that grants at least read privileges to that repo.",
+			"deployed_commit": {
+				Description: "For repo-based apps, the commit SHA currently deployed.",
 				Type:        schema.TypeString,
-				Optional:    true,
+				Computed:    true,
 			},
-			"image": {
-				Description: "Required for OCI-based apps, the image repository location.",
+			"url": {
+				Description: "The URL the app is reachable at.",
 				Type:        schema.TypeString,
-				Optional:    true,
+				Computed:    true,
+			},
+			"credential": {
+				Description:  "For repo-based apps hosted in private repositories, a personal access token that grants at least read privileges to that repo.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"repo"},
+			},
+			"image": {
+				Description:      "Required for OCI-based apps, the image repository location.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"image", "repo"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(imageRefRegexp, "must be an OCI image reference, example: nginx:1.25 or ghcr.io/org/app:v2")),
+			},
+			"registry_credential": {
+				Description:  "For OCI-based apps pulling from a private registry such as ghcr.io or an entrywan_registry, the credentials to log in with.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"image"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Description:      "The registry host with an optional port, example: ghcr.io or registry.example.com:5000.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(registryHostRegexp, "must be a registry host with an optional port, example: ghcr.io or registry.example.com:5000")),
+						},
+						"username": {
+							Description: "The registry username.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"password": {
+							Description: "The registry password or access token with read access to the image.",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+// resourceAppCustomizeDiff checks that the fields supplied match the
+// app source: image for oci apps and repo for github apps.
+func resourceAppCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	source := d.Get("source").(string)
+	switch source {
+	case "oci":
+		if d.Get("repo").(string) != "" {
+			return fmt.Errorf("repo cannot be set for oci apps, use image instead")
+		}
+	case "github":
+		if d.Get("image").(string) != "" {
+			return fmt.Errorf("image cannot be set for github apps, use repo and repobranch instead")
+		}
+	}
+	return nil
+}
+
+// appRegistryCredentialJson returns the configured registry
+// credential as JSON, or null when none is set.
+func appRegistryCredentialJson(d *schema.ResourceData) string {
+	credentials := d.Get("registry_credential").([]any)
+	if len(credentials) == 0 || credentials[0] == nil {
+		return "null"
+	}
+	credentialJson, _ := json.Marshal(credentials[0])
+	return string(credentialJson)
+}
+
+// appHealthcheckJson returns the configured health check as JSON, or
+// null when none is set.
+func appHealthcheckJson(d *schema.ResourceData) string {
+	healthchecks := d.Get("healthcheck").([]any)
+	if len(healthchecks) == 0 || healthchecks[0] == nil {
+		return "null"
+	}
+	healthcheck := healthchecks[0].(map[string]any)
+	healthcheckJson, _ := json.Marshal(appHealthcheck{
+		Path:        healthcheck["path"].(string),
+		Interval:    healthcheck["interval"].(int),
+		GracePeriod: healthcheck["grace_period"].(int),
+	})
+	return string(healthcheckJson)
+}
+
 type appCreateRes struct {
 	Id string `json:"id"`
 }
@@ -94,6 +271,10 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
 	repobranch := d.Get("repobranch").(string)
 	reporoot := d.Get("reporoot").(string)
 	credential := d.Get("credential").(string)
+	commit := d.Get("commit").(string)
+	replicas := d.Get("replicas").(int)
+	healthcheckJson := appHealthcheckJson(d)
+	projectJson := projectIdJson(resourceProjectId(d))
 	client := http.Client{}
 	var jb []byte
 	if source == "oci" {
@@ -103,8 +284,11 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
  "image": "%s",
  "size": %d,
  "port": %d,
- "source": "%s"}`,
-			name, location, image, size, port, source))
+ "replicas": %d,
+ "healthcheck": %s,
+ "registrycredential": %s,
+ "source": "%s"%s}`,
+			name, location, image, size, port, replicas, healthcheckJson, appRegistryCredentialJson(d), source, projectJson))
 	} else {
 		if credential == "" {
 			jb = []byte(fmt.Sprintf(
@@ -113,10 +297,13 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
  "repo": "%s",
  "repobranch": "%s",
  "reporoot": "%s",
+ "commit": "%s",
  "size": %d,
  "port": %d,
- "source": "%s"}`,
-				name, location, repo, repobranch, reporoot, size, port, source))
+ "replicas": %d,
+ "healthcheck": %s,
+ "source": "%s"%s}`,
+				name, location, repo, repobranch, reporoot, commit, size, port, replicas, healthcheckJson, source, projectJson))
 		} else {
 			jb = []byte(fmt.Sprintf(
 				`{"name": "%s",
@@ -124,11 +311,14 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
  "repo": "%s",
  "repobranch": "%s",
  "reporoot": "%s",
+ "commit": "%s",
  "credential": "%s",
  "size": %d,
  "port": %d,
- "source": "%s"}`,
-				name, location, repo, repobranch, reporoot, credential, size, port, source))
+ "replicas": %d,
+ "healthcheck": %s,
+ "source": "%s"%s}`,
+				name, location, repo, repobranch, reporoot, commit, credential, size, port, replicas, healthcheckJson, source, projectJson))
 		}
 	}
 	br := bytes.NewReader(jb)
@@ -153,12 +343,33 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
 		fmt.Printf("error unmarshaling request: %v", err)
 	}
 	d.SetId(cr.Id)
+	if source != "oci" {
+		if diags := appDeploy(ctx, d, d.Timeout(schema.TimeoutCreate)); diags != nil {
+			return diags
+		}
+	}
 	return resourceAppRead(ctx, d, m)
 }
 
+type appHealthcheck struct {
+	Path        string `json:"path"`
+	Interval    int    `json:"interval"`
+	GracePeriod int    `json:"graceperiod"`
+}
+
 type appGetRes struct {
-	State string `json:"state"`
-	Id    string `json:"id"`
+	State           string          `json:"state"`
+	Id              string          `json:"id"`
+	Name            string          `json:"name"`
+	Location        string          `json:"location"`
+	ProjectId       string          `json:"projectid"`
+	Source          string          `json:"source"`
+	Image           string          `json:"image"`
+	Url             string          `json:"url"`
+	Commit          string          `json:"commit"`
+	Replicas        int             `json:"replicas"`
+	RunningReplicas int             `json:"runningreplicas"`
+	Healthcheck     *appHealthcheck `json:"healthcheck"`
 }
 
 func resourceAppRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -183,29 +394,169 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, m any) diag.Di
 	}
 	d.SetId(cr.Id)
 	d.Set("state", cr.State)
+	d.Set("url", cr.Url)
+	d.Set("deployed_commit", cr.Commit)
+	d.Set("replicas", cr.Replicas)
+	d.Set("running_replicas", cr.RunningReplicas)
+	healthchecks := []map[string]any{}
+	if cr.Healthcheck != nil {
+		healthchecks = append(healthchecks, map[string]any{
+			"path":         cr.Healthcheck.Path,
+			"interval":     cr.Healthcheck.Interval,
+			"grace_period": cr.Healthcheck.GracePeriod,
+		})
+	}
+	d.Set("healthcheck", healthchecks)
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
 func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	if d.HasChange("registry_credential") {
+		jb := []byte(fmt.Sprintf(`{"registrycredential": %s}`, appRegistryCredentialJson(d)))
+		if diags := appPut(id, jb, "update app registry credential"); diags != nil {
+			return appKeepPriorState(d, diags, "registry_credential")
+		}
+	}
 	if d.HasChange("image") {
-		image := d.Get("image")
-		id := d.Id()
-		client := http.Client{}
-		jb := []byte(fmt.Sprintf(`{"image": "%s"}`, image))
-		br := bytes.NewReader(jb)
-		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/app/%s", endpoint, id), br)
-		if err != nil {
-			fmt.Printf("error forming request: %v", err)
+		jb := []byte(fmt.Sprintf(`{"image": "%s"}`, d.Get("image").(string)))
+		if diags := appPut(id, jb, "update app image"); diags != nil {
+			return appKeepPriorState(d, diags, "image")
 		}
-		req.Header.Set("Authorization", "Bearer "+token)
-		_, err = client.Do(req)
-		if err != nil {
-			fmt.Printf("error making request: %v", err)
+	}
+	if d.HasChanges("replicas", "healthcheck") {
+		jb := []byte(fmt.Sprintf(`{"replicas": %d, "healthcheck": %s}`, d.Get("replicas").(int), appHealthcheckJson(d)))
+		if diags := appPut(id, jb, "scale app"); diags != nil {
+			return appKeepPriorState(d, diags, "replicas", "healthcheck")
+		}
+	}
+	if d.HasChanges("commit", "redeploy_trigger") {
+		if diags := appDeploy(ctx, d, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return appKeepPriorState(d, diags, "commit", "redeploy_trigger")
 		}
 	}
 	return resourceAppRead(ctx, d, m)
 }
 
+// appPut applies a partial update to the app.  what describes the
+// update in the error returned when the API rejects it.
+func appPut(id string, jb []byte, what string) diag.Diagnostics {
+	client := http.Client{}
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/app/%s", endpoint, id), br)
+	if err != nil {
+		return diag.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to %s: %s", what, string(b))
+	}
+	return nil
+}
+
+// appKeepPriorState resets the given attributes to their values before
+// the update and returns diags.  A failed update otherwise records the
+// planned values in state, and the change would not be attempted again
+// on the next apply.  Updates that already succeeded are kept.
+func appKeepPriorState(d *schema.ResourceData, diags diag.Diagnostics, keys ...string) diag.Diagnostics {
+	for _, k := range keys {
+		old, _ := d.GetChange(k)
+		d.Set(k, old)
+	}
+	return diags
+}
+
+type appDeployRes struct {
+	Id    string `json:"id"`
+	State string `json:"state"`
+	Log   string `json:"log"`
+}
+
+// appDeployLogLines is the number of trailing build log lines included
+// in the error when a deployment fails.
+const appDeployLogLines = 20
+
+// appDeploy starts a new build of the app at the configured commit and
+// waits up to timeout for it to either succeed or fail.
+func appDeploy(ctx context.Context, d *schema.ResourceData, timeout time.Duration) diag.Diagnostics {
+	id := d.Id()
+	commit := d.Get("commit").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"commit": "%s"}`, commit))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/app/%s/deploy", endpoint, id), br)
+	if err != nil {
+		return diag.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to deploy app: %s", string(b))
+	}
+	var dr appDeployRes
+	err = json.Unmarshal(b, &dr)
+	if err != nil {
+		return diag.Errorf("error unmarshaling request: %v", err)
+	}
+	conf := &retry.StateChangeConf{
+		Pending: []string{"queued", "building", "deploying"},
+		Target:  []string{"succeeded", "failed"},
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+		Refresh: func() (any, string, error) {
+			req, err := http.NewRequest("GET", fmt.Sprintf("%s/app/%s/deploy/%s", endpoint, id, dr.Id), nil)
+			if err != nil {
+				return nil, "", err
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+			res, err := client.Do(req)
+			if err != nil {
+				return nil, "", err
+			}
+			b, err := ioutil.ReadAll(res.Body)
+			if err != nil {
+				return nil, "", err
+			}
+			if res.StatusCode != 200 {
+				return nil, "", fmt.Errorf("unable to read app deployment: %s", string(b))
+			}
+			var sr appDeployRes
+			err = json.Unmarshal(b, &sr)
+			if err != nil {
+				return nil, "", err
+			}
+			return sr, sr.State, nil
+		},
+	}
+	result, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for app deployment: %v", err)
+	}
+	if sr := result.(appDeployRes); sr.State == "failed" {
+		lines := strings.Split(strings.TrimRight(sr.Log, "\n"), "\n")
+		if len(lines) > appDeployLogLines {
+			lines = lines[len(lines)-appDeployLogLines:]
+		}
+		return diag.Errorf("app deployment failed:\n%s", strings.Join(lines, "\n"))
+	}
+	return nil
+}
+
 func resourceAppDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	id := d.Id()
 	client := http.Client{}
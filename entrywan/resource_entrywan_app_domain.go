@@ -0,0 +1,189 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// hostnameRegexp matches a fully qualified hostname with at least two
+// labels, example: www.example.com.
+var hostnameRegexp = regexp.MustCompile(`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`)
+
+func appDomainResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Custom domain attached to a PaaS application.  Create the DNS records listed in dns_records with your DNS provider so the domain can be verified and a certificate issued.  More information at https://www.entrywan.com/docs#apps",
+		CreateContext: resourceAppDomainCreate,
+		ReadContext:   resourceAppDomainRead,
+		DeleteContext: resourceAppDomainDelete,
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Description: "The ID of the app to attach the domain to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"hostname": {
+				Description:      "The fully qualified hostname to serve the app on, example: www.example.com.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(hostnameRegexp, "must be a fully qualified hostname")),
+			},
+			"dns_records": {
+				Description: "DNS records to create for routing and verifying the domain.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "Record type, either CNAME or TXT.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Record name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "Record value.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"verified": {
+				Description: "Whether the domain's DNS records have been verified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"cert_state": {
+				Description: "TLS certificate provisioning status, example: pending, issued or failed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type appDomainCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceAppDomainCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	appId := d.Get("app_id").(string)
+	hostname := d.Get("hostname").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"hostname": "%s"}`, hostname))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/app/%s/domain", endpoint, appId), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to add app domain: %s", string(b))
+	}
+	var cr appDomainCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceAppDomainRead(ctx, d, m)
+}
+
+type appDomainRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type appDomainGetRes struct {
+	Id         string            `json:"id"`
+	Hostname   string            `json:"hostname"`
+	Verified   bool              `json:"verified"`
+	CertState  string            `json:"certstate"`
+	DnsRecords []appDomainRecord `json:"dnsrecords"`
+}
+
+func resourceAppDomainRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	appId := d.Get("app_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/app/%s/domain/%s", endpoint, appId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read app domain: %s", string(b))
+	}
+	var cr appDomainGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	records := make([]map[string]any, len(cr.DnsRecords))
+	for i, r := range cr.DnsRecords {
+		records[i] = map[string]any{
+			"type":  r.Type,
+			"name":  r.Name,
+			"value": r.Value,
+		}
+	}
+	// Hostnames are case-insensitive, keep the configured spelling.
+	if cr.Hostname != "" && !strings.EqualFold(cr.Hostname, d.Get("hostname").(string)) {
+		d.Set("hostname", cr.Hostname)
+	}
+	d.Set("verified", cr.Verified)
+	d.Set("cert_state", cr.CertState)
+	d.Set("dns_records", records)
+	return nil
+}
+
+func resourceAppDomainDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	appId := d.Get("app_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/app/%s/domain/%s", endpoint, appId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
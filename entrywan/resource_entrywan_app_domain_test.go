@@ -0,0 +1,24 @@
+package entrywan
+
+import "testing"
+
+func TestHostnameRegexp(t *testing.T) {
+	cases := []struct {
+		hostname string
+		valid    bool
+	}{
+		{"example.com", true},
+		{"www.example.com", true},
+		{"my-app.eu.example.co.uk", true},
+		{"localhost", false},
+		{"-www.example.com", false},
+		{"www.example.com.", false},
+		{"www..example.com", false},
+		{"https://www.example.com", false},
+	}
+	for _, c := range cases {
+		if got := hostnameRegexp.MatchString(c.hostname); got != c.valid {
+			t.Errorf("hostnameRegexp.MatchString(%q) = %t, want %t", c.hostname, got, c.valid)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package entrywan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestImageRefRegexp(t *testing.T) {
+	cases := []struct {
+		image string
+		valid bool
+	}{
+		{"nginx", true},
+		{"nginx:1.25", true},
+		{"ghcr.io/org/app:v2", true},
+		{"registry:5000/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", true},
+		{"library/nginx:latest", true},
+		{"Nginx", false},
+		{"nginx:", false},
+		{"https://ghcr.io/org/app", false},
+		{"app@sha256:1234", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := imageRefRegexp.MatchString(c.image); got != c.valid {
+			t.Errorf("imageRefRegexp.MatchString(%q) = %t, want %t", c.image, got, c.valid)
+		}
+	}
+}
+
+func TestRegistryHostRegexp(t *testing.T) {
+	cases := []struct {
+		host  string
+		valid bool
+	}{
+		{"ghcr.io", true},
+		{"registry.example.com:5000", true},
+		{"localhost:5000", true},
+		{"localhost", true},
+		{"registry.example.com:", false},
+		{"registry.example.com:port", false},
+		{"ghcr.io/org", false},
+		{"https://ghcr.io", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := registryHostRegexp.MatchString(c.host); got != c.valid {
+			t.Errorf("registryHostRegexp.MatchString(%q) = %t, want %t", c.host, got, c.valid)
+		}
+	}
+}
+
+func TestResourceAppCustomizeDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema:        appResource().Schema,
+		CustomizeDiff: resourceAppCustomizeDiff,
+	}
+	cases := []struct {
+		name    string
+		raw     map[string]any
+		wantErr bool
+	}{
+		{"oci with image", map[string]any{"source": "oci", "image": "nginx"}, false},
+		{"oci with repo", map[string]any{"source": "oci", "repo": "https://github.com/org/app", "repobranch": "main"}, true},
+		{"github with repo", map[string]any{"source": "github", "repo": "https://github.com/org/app", "repobranch": "main"}, false},
+		{"github with image", map[string]any{"source": "github", "image": "nginx"}, true},
+	}
+	for _, c := range cases {
+		err := testResourceDiff(r, nil, c.raw)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %t", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestResourceAppUpdateKeepsPriorStateOnFailure(t *testing.T) {
+	testServeJson(t, map[string]any{})
+	r := appResource()
+	state := &terraform.InstanceState{
+		ID: "app1",
+		Attributes: map[string]string{
+			"id":                   "app1",
+			"name":                 "myapp",
+			"location":             "us1",
+			"size":                 "512",
+			"port":                 "8080",
+			"replicas":             "1",
+			"source":               "github",
+			"repo":                 "https://github.com/org/app",
+			"repobranch":           "main",
+			"commit":               "abc123",
+			"redeploy_trigger.%":   "1",
+			"redeploy_trigger.run": "1",
+		},
+	}
+	raw := map[string]any{
+		"name":             "myapp",
+		"location":         "us1",
+		"size":             512,
+		"port":             8080,
+		"source":           "github",
+		"repo":             "https://github.com/org/app",
+		"repobranch":       "main",
+		"commit":           "def456",
+		"redeploy_trigger": map[string]any{"run": "2"},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, diags := r.Apply(context.Background(), state, diff, nil)
+	if !diags.HasError() {
+		t.Fatal("expected the deployment to fail")
+	}
+	if got := s.Attributes["commit"]; got != "abc123" {
+		t.Errorf("commit = %q, want the prior abc123", got)
+	}
+	if got := s.Attributes["redeploy_trigger.run"]; got != "1" {
+		t.Errorf("redeploy_trigger.run = %q, want the prior 1", got)
+	}
+}
+
+func TestAppHealthcheckJson(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]any
+		want string
+	}{
+		{"none", map[string]any{}, "null"},
+		{"defaults", map[string]any{"healthcheck": []any{map[string]any{"path": "/healthz"}}}, `{"path":"/healthz","interval":10,"graceperiod":30}`},
+		{"all set", map[string]any{"healthcheck": []any{map[string]any{"path": "/up", "interval": 5, "grace_period": 60}}}, `{"path":"/up","interval":5,"graceperiod":60}`},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, appResource().Schema, c.raw)
+		if got := appHealthcheckJson(d); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
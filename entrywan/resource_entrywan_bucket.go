@@ -0,0 +1,264 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// bucketNameRegexp matches S3 compatible bucket names: 3 to 63
+// lowercase letters, digits, dots and hyphens.
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func bucketResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "S3 compatible object storage bucket.  Grant access to it with entrywan_bucket_key.  More information at https://www.entrywan.com/docs#objectstorage",
+		CreateContext: resourceBucketCreate,
+		ReadContext:   resourceBucketRead,
+		UpdateContext: resourceBucketUpdate,
+		DeleteContext: resourceBucketDelete,
+		CustomizeDiff: customdiff.All(
+			validateLocation("buckets"),
+			customdiff.ValidateChange("versioning", func(ctx context.Context, old, new, m any) error {
+				if old.(bool) && !new.(bool) {
+					return fmt.Errorf("versioning cannot be disabled once enabled")
+				}
+				return nil
+			}),
+		),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:      "The globally unique bucket name, 3 to 63 lowercase letters, digits, dots and hyphens.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(bucketNameRegexp, "must be 3 to 63 lowercase letters, digits, dots and hyphens")),
+			},
+			"location": {
+				Description: "The physical data center the bucket is stored in.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"acl": {
+				Description:      "Access control for anonymous clients, either private or public-read.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "private",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"private", "public-read"}, false)),
+			},
+			"versioning": {
+				Description: "Whether to keep previous versions of overwritten and deleted objects.  Cannot be disabled once enabled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"lifecycle_rule": {
+				Description: "Rules for expiring objects automatically.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prefix": {
+							Description: "Only expire objects whose key starts with this prefix.  Applies to the whole bucket when empty.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+						},
+						"expiration_days": {
+							Description:      "Number of days after creation an object is deleted.",
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+						"noncurrent_expiration_days": {
+							Description:      "Number of days after being overwritten or deleted a previous object version is removed.  Only applies with versioning enabled.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Description: "The S3 endpoint URL to use with the bucket.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// bucketLifecycleJson returns the configured lifecycle rules as JSON.
+func bucketLifecycleJson(d *schema.ResourceData) string {
+	rules := []bucketLifecycleRule{}
+	for _, r := range d.Get("lifecycle_rule").([]any) {
+		rule := r.(map[string]any)
+		rules = append(rules, bucketLifecycleRule{
+			Prefix:                   rule["prefix"].(string),
+			ExpirationDays:           rule["expiration_days"].(int),
+			NoncurrentExpirationDays: rule["noncurrent_expiration_days"].(int),
+		})
+	}
+	lifecycleJson, _ := json.Marshal(rules)
+	return string(lifecycleJson)
+}
+
+type bucketCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	acl := d.Get("acl").(string)
+	versioning := d.Get("versioning").(bool)
+	lifecycleJson := bucketLifecycleJson(d)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(
+		`{"name": "%s",
+ "location": "%s",
+ "acl": "%s",
+ "versioning": %t,
+ "lifecycle": %s}`,
+		name, location, acl, versioning, lifecycleJson))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/bucket", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create bucket: %s", string(b))
+	}
+	var cr bucketCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceBucketRead(ctx, d, m)
+}
+
+type bucketLifecycleRule struct {
+	Prefix                   string `json:"prefix"`
+	ExpirationDays           int    `json:"expirationdays"`
+	NoncurrentExpirationDays int    `json:"noncurrentexpirationdays"`
+}
+
+type bucketGetRes struct {
+	Id         string                `json:"id"`
+	Name       string                `json:"name"`
+	Location   string                `json:"location"`
+	Acl        string                `json:"acl"`
+	Versioning bool                  `json:"versioning"`
+	Lifecycle  []bucketLifecycleRule `json:"lifecycle"`
+	Endpoint   string                `json:"endpoint"`
+}
+
+func resourceBucketRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/bucket/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read bucket: %s", string(b))
+	}
+	var cr bucketGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	rules := make([]map[string]any, len(cr.Lifecycle))
+	for i, r := range cr.Lifecycle {
+		rules[i] = map[string]any{
+			"prefix":                     r.Prefix,
+			"expiration_days":            r.ExpirationDays,
+			"noncurrent_expiration_days": r.NoncurrentExpirationDays,
+		}
+	}
+	d.Set("name", cr.Name)
+	d.Set("location", cr.Location)
+	d.Set("acl", cr.Acl)
+	d.Set("versioning", cr.Versioning)
+	d.Set("lifecycle_rule", rules)
+	d.Set("endpoint", cr.Endpoint)
+	return nil
+}
+
+func resourceBucketUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChanges("acl", "versioning", "lifecycle_rule") {
+		id := d.Id()
+		acl := d.Get("acl").(string)
+		versioning := d.Get("versioning").(bool)
+		lifecycleJson := bucketLifecycleJson(d)
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"acl": "%s", "versioning": %t, "lifecycle": %s}`, acl, versioning, lifecycleJson))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/bucket/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update bucket: %s", string(b))
+		}
+	}
+	return resourceBucketRead(ctx, d, m)
+}
+
+func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/bucket/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete bucket: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,163 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func bucketKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "S3 compatible access key scoped to a single bucket.  The secret is only returned when the key is created and is stored in the Terraform state.  More information at https://www.entrywan.com/docs#objectstorage",
+		CreateContext: resourceBucketKeyCreate,
+		ReadContext:   resourceBucketKeyRead,
+		DeleteContext: resourceBucketKeyDelete,
+		Schema: map[string]*schema.Schema{
+			"bucket_id": {
+				Description: "The ID of the bucket the key grants access to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "A handy name for remembering which key is which.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"permission": {
+				Description:      "The operations the key may perform, one of read, write or readwrite.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "readwrite",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"read", "write", "readwrite"}, false)),
+			},
+			"prefix": {
+				Description: "Restrict the key to objects whose key starts with this prefix.  Grants access to the whole bucket when empty.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+			},
+			"access_key_id": {
+				Description: "The S3 access key ID.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret_access_key": {
+				Description: "The S3 secret access key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+type bucketKeyCreateRes struct {
+	Id              string `json:"id"`
+	AccessKeyId     string `json:"accesskeyid"`
+	SecretAccessKey string `json:"secretaccesskey"`
+}
+
+func resourceBucketKeyCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	bucketId := d.Get("bucket_id").(string)
+	name := d.Get("name").(string)
+	permission := d.Get("permission").(string)
+	prefix := d.Get("prefix").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "permission": "%s", "prefix": "%s"}`, name, permission, prefix))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/bucket/%s/key", endpoint, bucketId), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create bucket key: %s", string(b))
+	}
+	var cr bucketKeyCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	d.Set("access_key_id", cr.AccessKeyId)
+	d.Set("secret_access_key", cr.SecretAccessKey)
+	return resourceBucketKeyRead(ctx, d, m)
+}
+
+type bucketKeyGetRes struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Permission  string `json:"permission"`
+	Prefix      string `json:"prefix"`
+	AccessKeyId string `json:"accesskeyid"`
+}
+
+func resourceBucketKeyRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	bucketId := d.Get("bucket_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/bucket/%s/key/%s", endpoint, bucketId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read bucket key: %s", string(b))
+	}
+	var cr bucketKeyGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("name", cr.Name)
+	d.Set("permission", cr.Permission)
+	d.Set("prefix", cr.Prefix)
+	d.Set("access_key_id", cr.AccessKeyId)
+	return nil
+}
+
+func resourceBucketKeyDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	bucketId := d.Get("bucket_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/bucket/%s/key/%s", endpoint, bucketId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
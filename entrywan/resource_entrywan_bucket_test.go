@@ -0,0 +1,51 @@
+package entrywan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBucketNameRegexp(t *testing.T) {
+	cases := []struct {
+		name  string
+		valid bool
+	}{
+		{"uploads", true},
+		{"my-app.uploads", true},
+		{"abc", true},
+		{strings.Repeat("a", 63), true},
+		{"ab", false},
+		{strings.Repeat("a", 64), false},
+		{"Uploads", false},
+		{"-uploads", false},
+		{"uploads-", false},
+		{"my_uploads", false},
+	}
+	for _, c := range cases {
+		if got := bucketNameRegexp.MatchString(c.name); got != c.valid {
+			t.Errorf("bucketNameRegexp.MatchString(%q) = %t, want %t", c.name, got, c.valid)
+		}
+	}
+}
+
+func TestBucketLifecycleJson(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]any
+		want string
+	}{
+		{"none", map[string]any{}, "[]"},
+		{"expiry only", map[string]any{"lifecycle_rule": []any{map[string]any{"expiration_days": 30}}}, `[{"prefix":"","expirationdays":30,"noncurrentexpirationdays":0}]`},
+		{"all set", map[string]any{"lifecycle_rule": []any{map[string]any{"prefix": "tmp/", "expiration_days": 7, "noncurrent_expiration_days": 1}}}, `[{"prefix":"tmp/","expirationdays":7,"noncurrentexpirationdays":1}]`},
+	}
+	for _, c := range cases {
+		c.raw["name"] = "uploads"
+		c.raw["location"] = "us1"
+		d := schema.TestResourceDataRaw(t, bucketResource().Schema, c.raw)
+		if got := bucketLifecycleJson(d); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
@@ -19,6 +19,7 @@ func clusterResource() *schema.Resource {
 		ReadContext:   resourceClusterRead,
 		UpdateContext: resourceClusterUpdate,
 		DeleteContext: resourceClusterDelete,
+		CustomizeDiff: validateLocation("clusters"),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "A handy name for remembering which cluster is which.",
@@ -30,6 +31,7 @@ func clusterResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"size": {
 				Description: "The number of worker nodes.  Can be scaled up or down as needed.",
 				Type:        schema.TypeInt,
@@ -69,14 +71,15 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m any) d
 	version := d.Get("version").(string)
 	size := d.Get("size").(int)
 	cni := d.Get("cni").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	client := http.Client{}
 	jb := []byte(fmt.Sprintf(
 		`{"name": "%s",
  "location": "%s",
  "version": "%s",
  "size": %d,
- "cni": "%s"}`,
-		name, location, version, size, cni))
+ "cni": "%s"%s}`,
+		name, location, version, size, cni, projectJson))
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/cluster", br)
 	if err != nil {
@@ -107,6 +110,10 @@ type clusterGetRes struct {
 	Apiserver string `json:"apiserver"`
 	Version   string `json:"version"`
 	Id        string `json:"id"`
+	Name      string `json:"name"`
+	Location  string `json:"location"`
+	ProjectId string `json:"projectid"`
+	Cni       string `json:"cni"`
 	Size      int    `json:"size"`
 }
 
@@ -135,6 +142,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m any) dia
 	d.Set("apiserver", cr.Apiserver)
 	d.Set("version", cr.Version)
 	d.Set("size", cr.Size)
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
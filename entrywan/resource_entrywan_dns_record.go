@@ -0,0 +1,269 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dnsRecordResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "DNS record in an entrywan_dns_zone.  More information at https://www.entrywan.com/docs#dns",
+		CreateContext: resourceDnsRecordCreate,
+		ReadContext:   resourceDnsRecordRead,
+		UpdateContext: resourceDnsRecordUpdate,
+		DeleteContext: resourceDnsRecordDelete,
+		CustomizeDiff: resourceDnsRecordCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The ID of the zone the record belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:      "The record name relative to the zone, example: www.  Use @ for the zone apex.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressDnsRecordNameDiff,
+			},
+			"type": {
+				Description:      "Record type, one of A, AAAA, CNAME, MX, TXT, SRV or CAA.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "CAA"}, false)),
+			},
+			"value": {
+				Description: "Record value, example: an instance's ip4 for an A record or entrywan_loadbalancer.ip.  For SRV records the target hostname, for CAA records the flags, tag and value, example: 0 issue \"letsencrypt.org\".",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ttl": {
+				Description:      "Time to live in seconds.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          3600,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(60, 86400)),
+			},
+			"priority": {
+				Description: "Priority for MX and SRV records.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"weight": {
+				Description: "Weight for SRV records.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"port": {
+				Description: "Target port for SRV records.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"fqdn": {
+				Description: "The fully qualified name of the record.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// normalizeDnsRecordName lowercases a record name and strips any
+// trailing dot, using @ for the zone apex.
+func normalizeDnsRecordName(name string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	if name == "" {
+		return "@"
+	}
+	return name
+}
+
+// suppressDnsRecordNameDiff ignores differences in case and trailing
+// dots, so only a different name forces replacement.
+func suppressDnsRecordNameDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeDnsRecordName(old) == normalizeDnsRecordName(new)
+}
+
+// resourceDnsRecordCustomizeDiff checks that A and AAAA values are
+// addresses of the right family and that SRV records carry a port.
+func resourceDnsRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+	recordType := d.Get("type").(string)
+	value := d.Get("value").(string)
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("A record value must be an IPv4 address, got: %s", value)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("AAAA record value must be an IPv6 address, got: %s", value)
+		}
+	case "SRV":
+		if d.NewValueKnown("port") && d.Get("port").(int) == 0 {
+			return fmt.Errorf("SRV records require port")
+		}
+	}
+	return nil
+}
+
+type dnsRecordCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceDnsRecordCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	zoneId := d.Get("zone_id").(string)
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+	value := d.Get("value").(string)
+	ttl := d.Get("ttl").(int)
+	priority := d.Get("priority").(int)
+	weight := d.Get("weight").(int)
+	port := d.Get("port").(int)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(
+		`{"name": "%s",
+ "type": "%s",
+ "value": %q,
+ "ttl": %d,
+ "priority": %d,
+ "weight": %d,
+ "port": %d}`,
+		name, recordType, value, ttl, priority, weight, port))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/dnszone/%s/record", endpoint, zoneId), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create dns record: %s", string(b))
+	}
+	var cr dnsRecordCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceDnsRecordRead(ctx, d, m)
+}
+
+type dnsRecordGetRes struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Ttl      int    `json:"ttl"`
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+	Port     int    `json:"port"`
+	Fqdn     string `json:"fqdn"`
+}
+
+func resourceDnsRecordRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	zoneId := d.Get("zone_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/dnszone/%s/record/%s", endpoint, zoneId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read dns record: %s", string(b))
+	}
+	var cr dnsRecordGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	// The name and type of a record never change, and the API may
+	// return the name fully qualified, so the configured values are kept.
+	d.Set("value", cr.Value)
+	d.Set("ttl", cr.Ttl)
+	d.Set("priority", cr.Priority)
+	d.Set("weight", cr.Weight)
+	d.Set("port", cr.Port)
+	d.Set("fqdn", cr.Fqdn)
+	return nil
+}
+
+func resourceDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChanges("value", "ttl", "priority", "weight", "port") {
+		id := d.Id()
+		zoneId := d.Get("zone_id").(string)
+		value := d.Get("value").(string)
+		ttl := d.Get("ttl").(int)
+		priority := d.Get("priority").(int)
+		weight := d.Get("weight").(int)
+		port := d.Get("port").(int)
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"value": %q, "ttl": %d, "priority": %d, "weight": %d, "port": %d}`,
+			value, ttl, priority, weight, port))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/dnszone/%s/record/%s", endpoint, zoneId, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update dns record: %s", string(b))
+		}
+	}
+	return resourceDnsRecordRead(ctx, d, m)
+}
+
+func resourceDnsRecordDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	zoneId := d.Get("zone_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/dnszone/%s/record/%s", endpoint, zoneId, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
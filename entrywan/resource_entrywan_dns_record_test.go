@@ -0,0 +1,50 @@
+package entrywan
+
+import "testing"
+
+func TestResourceDnsRecordCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		name    string
+		raw     map[string]any
+		wantErr bool
+	}{
+		{"A with IPv4", map[string]any{"type": "A", "value": "192.0.2.10"}, false},
+		{"A with IPv6", map[string]any{"type": "A", "value": "2001:db8::10"}, true},
+		{"A with hostname", map[string]any{"type": "A", "value": "www.example.com"}, true},
+		{"AAAA with IPv6", map[string]any{"type": "AAAA", "value": "2001:db8::10"}, false},
+		{"AAAA with IPv4", map[string]any{"type": "AAAA", "value": "192.0.2.10"}, true},
+		{"A not yet known", map[string]any{"type": "A", "value": testUnknownValue}, false},
+		{"SRV with port", map[string]any{"type": "SRV", "value": "sip.example.com", "port": 5060}, false},
+		{"SRV without port", map[string]any{"type": "SRV", "value": "sip.example.com"}, true},
+		{"SRV port not yet known", map[string]any{"type": "SRV", "value": "sip.example.com", "port": testUnknownValue}, false},
+		{"CNAME", map[string]any{"type": "CNAME", "value": "www.example.com"}, false},
+	}
+	for _, c := range cases {
+		c.raw["zone_id"] = "zone1"
+		c.raw["name"] = "www"
+		err := testResourceDiff(dnsRecordResource(), nil, c.raw)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%s: got error %v, want error %t", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestSuppressDnsRecordNameDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"www", "www", true},
+		{"www", "WWW", true},
+		{"www.", "www", true},
+		{"@", "", true},
+		{"@", ".", true},
+		{"www", "api", false},
+		{"www", "@", false},
+	}
+	for _, c := range cases {
+		if got := suppressDnsRecordNameDiff("name", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("suppressDnsRecordNameDiff(%q, %q) = %t, want %t", c.old, c.new, got, c.suppress)
+		}
+	}
+}
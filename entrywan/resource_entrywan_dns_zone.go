@@ -0,0 +1,127 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dnsZoneResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Authoritative DNS zone for a domain.  Delegate the domain to the zone's nameservers at your registrar.  More information at https://www.entrywan.com/docs#dns",
+		CreateContext: resourceDnsZoneCreate,
+		ReadContext:   resourceDnsZoneRead,
+		DeleteContext: resourceDnsZoneDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description:      "The domain the zone is authoritative for, example: example.com.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(hostnameRegexp, "must be a fully qualified domain name")),
+			},
+			"nameservers": {
+				Description: "The nameservers to delegate the domain to.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+type dnsZoneCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceDnsZoneCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s"}`, name))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/dnszone", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create dns zone: %s", string(b))
+	}
+	var cr dnsZoneCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceDnsZoneRead(ctx, d, m)
+}
+
+type dnsZoneGetRes struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Nameservers []string `json:"nameservers"`
+}
+
+func resourceDnsZoneRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/dnszone/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read dns zone: %s", string(b))
+	}
+	var cr dnsZoneGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("name", cr.Name)
+	d.Set("nameservers", cr.Nameservers)
+	return nil
+}
+
+func resourceDnsZoneDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/dnszone/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func firewallResource() *schema.Resource {
@@ -25,6 +26,7 @@ func firewallResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"rules": {
 				Required: true,
 				Type:     schema.TypeList,
@@ -36,9 +38,13 @@ func firewallResource() *schema.Resource {
 							Optional:    true,
 						},
 						"src": {
-							Description: "Source address of traffic",
+							Description: "Source address of traffic, an IPv4 or IPv6 address or CIDR block, example: 10.0.0.0/8 or 2001:db8::/32.  Use 0.0.0.0/0 or ::/0 to allow traffic from anywhere.",
 							Type:        schema.TypeString,
-							Optional:    true,
+							Required:    true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.Any(
+								validation.IsIPAddress,
+								validation.IsCIDR,
+							)),
 						},
 						"protocol": {
 							Description: "Traffic protocol, either all, tcp, udp, icmp and a few others.",
@@ -64,11 +70,12 @@ type Rule struct {
 
 func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	name := d.Get("name").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	rulesIface := d.Get("rules").([]interface{})
 	rulesJson := []byte("[]")
 	rulesJson, _ = json.Marshal(rulesIface)
 	client := http.Client{}
-	jb := []byte(fmt.Sprintf(`{"name": "%s", "rules": %s}`, name, rulesJson))
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "rules": %s%s}`, name, rulesJson, projectJson))
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/firewall", br)
 	if err != nil {
@@ -95,6 +102,34 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m any)
 }
 
 func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/firewall/%s", endpoint, id), nil)
+	if err != nil {
+		return diag.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read firewall: %s", string(b))
+	}
+	var cr firewallGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return diag.Errorf("error unmarshaling response: %v", err)
+	}
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
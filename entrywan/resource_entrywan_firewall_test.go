@@ -0,0 +1,60 @@
+package entrywan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceFirewallRead(t *testing.T) {
+	testServeJson(t, map[string]any{
+		"/firewall/fw1": firewallGetRes{Id: "fw1", ProjectId: "prj1"},
+		"/firewall/fw2": firewallGetRes{Id: "fw2"},
+	})
+	cases := []struct {
+		id            string
+		wantId        string
+		wantProjectId string
+	}{
+		{"fw1", "fw1", "prj1"},
+		{"fw2", "fw2", "prj9"},
+		{"fw3", "", "prj9"},
+	}
+	for _, c := range cases {
+		d := firewallResource().TestResourceData()
+		d.SetId(c.id)
+		d.Set("project_id", "prj9")
+		if diags := resourceFirewallRead(context.Background(), d, nil); diags.HasError() {
+			t.Errorf("%s: unexpected error: %v", c.id, diags)
+			continue
+		}
+		if d.Id() != c.wantId {
+			t.Errorf("%s: id = %q, want %q", c.id, d.Id(), c.wantId)
+		}
+		if got := d.Get("project_id").(string); got != c.wantProjectId {
+			t.Errorf("%s: project_id = %q, want %q", c.id, got, c.wantProjectId)
+		}
+	}
+}
+
+func TestFirewallRuleSrc(t *testing.T) {
+	cases := []struct {
+		name    string
+		rule    map[string]any
+		wantErr bool
+	}{
+		{"ipv4 cidr", map[string]any{"port": "22", "protocol": "tcp", "src": "10.0.0.0/8"}, false},
+		{"ipv6 address", map[string]any{"port": "22", "protocol": "tcp", "src": "2001:db8::1"}, false},
+		{"hostname", map[string]any{"port": "22", "protocol": "tcp", "src": "example.com"}, true},
+		{"empty", map[string]any{"port": "22", "protocol": "tcp", "src": ""}, true},
+		{"omitted", map[string]any{"port": "22", "protocol": "tcp"}, true},
+	}
+	for _, c := range cases {
+		raw := map[string]any{"name": "web", "rules": []any{c.rule}}
+		diags := firewallResource().Validate(terraform.NewResourceConfigRaw(raw))
+		if diags.HasError() != c.wantErr {
+			t.Errorf("%s: got %v, want error %t", c.name, diags, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// iamScopeRegexp matches an IAM scope of the form service:access, such
+// as instances:read or apps:write, or * for full access.
+var iamScopeRegexp = regexp.MustCompile(`^(?:\*|[a-z]+:(?:read|write|\*))$`)
+
+// iamScopesSchema returns the schema for a set of IAM scopes.
+func iamScopesSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeSet,
+		Required:    true,
+		ForceNew:    forceNew,
+		MinItems:    1,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(iamScopeRegexp, "must be service:read, service:write, service:* or *, example: instances:read")),
+		},
+	}
+}
+
+func iamRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "IAM role granting a set of scopes to the users it is assigned to.  More information at https://www.entrywan.com/docs#iam",
+		CreateContext: resourceIamRoleCreate,
+		ReadContext:   resourceIamRoleRead,
+		UpdateContext: resourceIamRoleUpdate,
+		DeleteContext: resourceIamRoleDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The role name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "What the role is for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"scopes": iamScopesSchema("Scopes granted by the role, example: instances:read, apps:write or *.", false),
+		},
+	}
+}
+
+type iamRoleCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceIamRoleCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	scopesJson, _ := json.Marshal(d.Get("scopes").(*schema.Set).List())
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "description": %q, "scopes": %s}`, name, description, scopesJson))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/iam/role", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create iam role: %s", string(b))
+	}
+	var cr iamRoleCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceIamRoleRead(ctx, d, m)
+}
+
+type iamRoleGetRes struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+}
+
+func resourceIamRoleRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/iam/role/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read iam role: %s", string(b))
+	}
+	var cr iamRoleGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("name", cr.Name)
+	d.Set("description", cr.Description)
+	d.Set("scopes", cr.Scopes)
+	return nil
+}
+
+func resourceIamRoleUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChanges("name", "description", "scopes") {
+		id := d.Id()
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+		scopesJson, _ := json.Marshal(d.Get("scopes").(*schema.Set).List())
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"name": "%s", "description": %q, "scopes": %s}`, name, description, scopesJson))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/iam/role/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update iam role: %s", string(b))
+		}
+	}
+	return resourceIamRoleRead(ctx, d, m)
+}
+
+func resourceIamRoleDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/iam/role/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete iam role: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
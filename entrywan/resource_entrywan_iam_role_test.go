@@ -0,0 +1,25 @@
+package entrywan
+
+import "testing"
+
+func TestIamScopeRegexp(t *testing.T) {
+	cases := []struct {
+		scope string
+		valid bool
+	}{
+		{"*", true},
+		{"instances:read", true},
+		{"apps:write", true},
+		{"registries:*", true},
+		{"instances", false},
+		{"instances:delete", false},
+		{"Instances:read", false},
+		{"*:read", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := iamScopeRegexp.MatchString(c.scope); got != c.valid {
+			t.Errorf("iamScopeRegexp.MatchString(%q) = %t, want %t", c.scope, got, c.valid)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func iamTokenResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "IAM API token limited to a set of scopes, for use by CI pipelines and other automation.  The secret is only returned when the token is created and is stored in the Terraform state.  A token that has expired is recreated on the next apply.  More information at https://www.entrywan.com/docs#iam",
+		CreateContext: resourceIamTokenCreate,
+		ReadContext:   resourceIamTokenRead,
+		DeleteContext: resourceIamTokenDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "A handy name for remembering which token is which.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scopes": iamScopesSchema("Scopes the token is granted, example: instances:read, apps:write or *.", true),
+			"expires_at": {
+				Description:      "When the token stops working, as an RFC 3339 timestamp, example: 2025-01-01T00:00:00Z.  When absent, the token gets the API's default expiry, if any.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				DiffSuppressFunc: suppressEquivalentTimeDiff,
+			},
+			"secret": {
+				Description: "The token to use as the provider token or the Authorization bearer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentTimeDiff ignores differences in how an RFC 3339
+// timestamp is written, such as the timezone offset or fractional
+// seconds, so only a different instant forces replacement.
+func suppressEquivalentTimeDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+type iamTokenCreateRes struct {
+	Id     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+func resourceIamTokenCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	expiresAt := d.Get("expires_at").(string)
+	scopesJson, _ := json.Marshal(d.Get("scopes").(*schema.Set).List())
+	client := http.Client{}
+	var jb []byte
+	if expiresAt == "" {
+		jb = []byte(fmt.Sprintf(`{"name": "%s", "scopes": %s}`, name, scopesJson))
+	} else {
+		jb = []byte(fmt.Sprintf(`{"name": "%s", "scopes": %s, "expiresat": "%s"}`, name, scopesJson, expiresAt))
+	}
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/iam/token", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create iam token: %s", string(b))
+	}
+	var cr iamTokenCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	d.Set("secret", cr.Secret)
+	return resourceIamTokenRead(ctx, d, m)
+}
+
+type iamTokenGetRes struct {
+	Id        string   `json:"id"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expiresat"`
+	Expired   bool     `json:"expired"`
+}
+
+func resourceIamTokenRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/iam/token/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read iam token: %s", string(b))
+	}
+	var cr iamTokenGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	if cr.Expired {
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", cr.Name)
+	d.Set("scopes", cr.Scopes)
+	d.Set("expires_at", cr.ExpiresAt)
+	return nil
+}
+
+func resourceIamTokenDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/iam/token/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to revoke iam token: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
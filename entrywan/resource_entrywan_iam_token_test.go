@@ -0,0 +1,57 @@
+package entrywan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSuppressEquivalentTimeDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"2025-01-01T00:00:00Z", "2025-01-01T00:00:00Z", true},
+		{"2025-01-01T00:00:00Z", "2025-01-01T01:00:00+01:00", true},
+		{"2025-01-01T00:00:00.000Z", "2025-01-01T00:00:00Z", true},
+		{"2025-01-01T00:00:00Z", "2025-01-02T00:00:00Z", false},
+		{"", "2025-01-01T00:00:00Z", false},
+		{"2025-01-01T00:00:00Z", "", false},
+	}
+	for _, c := range cases {
+		if got := suppressEquivalentTimeDiff("expires_at", c.old, c.new, nil); got != c.suppress {
+			t.Errorf("suppressEquivalentTimeDiff(%q, %q) = %t, want %t", c.old, c.new, got, c.suppress)
+		}
+	}
+}
+
+func TestIamTokenExpiresAtDiff(t *testing.T) {
+	r := iamTokenResource()
+	cases := []struct {
+		name        string
+		expiresAt   string
+		wantReplace bool
+	}{
+		{"default expiry kept", "", false},
+		{"same expiry", "2025-01-01T00:00:00Z", false},
+		{"new expiry", "2026-01-01T00:00:00Z", true},
+	}
+	for _, c := range cases {
+		raw := map[string]any{"name": "ci", "scopes": []any{"apps:write"}}
+		d := schema.TestResourceDataRaw(t, r.Schema, raw)
+		d.SetId("tok1")
+		d.Set("expires_at", "2025-01-01T00:00:00Z")
+		if c.expiresAt != "" {
+			raw["expires_at"] = c.expiresAt
+		}
+		diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := diff != nil && diff.RequiresNew(); got != c.wantReplace {
+			t.Errorf("%s: replace = %t, want %t", c.name, got, c.wantReplace)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func iamUserResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "IAM user in the account.  New users receive an email invitation and are granted the scopes of their roles.  More information at https://www.entrywan.com/docs#iam",
+		CreateContext: resourceIamUserCreate,
+		ReadContext:   resourceIamUserRead,
+		UpdateContext: resourceIamUserUpdate,
+		DeleteContext: resourceIamUserDelete,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description:      "The user's email address the invitation is sent to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`), "must be an email address")),
+			},
+			"role_ids": {
+				Description: "IDs of the entrywan_iam_role resources assigned to the user.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"state": {
+				Description: "User state, either invited or active.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type iamUserCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceIamUserCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	email := d.Get("email").(string)
+	roleIdsJson, _ := json.Marshal(d.Get("role_ids").(*schema.Set).List())
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"email": "%s", "roleids": %s}`, email, roleIdsJson))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/iam/user", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create iam user: %s", string(b))
+	}
+	var cr iamUserCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceIamUserRead(ctx, d, m)
+}
+
+type iamUserGetRes struct {
+	Id      string   `json:"id"`
+	Email   string   `json:"email"`
+	RoleIds []string `json:"roleids"`
+	State   string   `json:"state"`
+}
+
+func resourceIamUserRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/iam/user/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read iam user: %s", string(b))
+	}
+	var cr iamUserGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("email", cr.Email)
+	d.Set("role_ids", cr.RoleIds)
+	d.Set("state", cr.State)
+	return nil
+}
+
+func resourceIamUserUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("role_ids") {
+		id := d.Id()
+		roleIdsJson, _ := json.Marshal(d.Get("role_ids").(*schema.Set).List())
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"roleids": %s}`, roleIdsJson))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/iam/user/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update iam user roles: %s", string(b))
+		}
+	}
+	return resourceIamUserRead(ctx, d, m)
+}
+
+func resourceIamUserDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/iam/user/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete iam user: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
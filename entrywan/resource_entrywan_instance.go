@@ -9,7 +9,9 @@ import (
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func instanceResource() *schema.Resource {
@@ -19,6 +21,11 @@ func instanceResource() *schema.Resource {
 		ReadContext:   resourceInstanceRead,
 		UpdateContext: resourceInstanceUpdate,
 		DeleteContext: resourceInstanceDelete,
+		CustomizeDiff: customdiff.All(
+			validateLocation("instances"),
+			validateImage,
+			validateInstanceShape,
+		),
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Description: "The instance's hostname.  The machine is booted with this hostname on first boot.",
@@ -30,6 +37,7 @@ func instanceResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"disk": {
 				Description: "Hard disk disk in GB.",
 				Type:        schema.TypeInt,
@@ -46,14 +54,33 @@ func instanceResource() *schema.Resource {
 				Required:    true,
 			},
 			"sshkey": {
-				Description: "The ssh key to be placed as authorized_keys on the machine.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The name of an ssh key to be placed as authorized_keys on the machine.  Only used when the instance is created.  Prefer sshkey_ids, which also lets Terraform order key creation before the instance.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"sshkey", "sshkey_ids"},
+			},
+			"sshkey_ids": {
+				Description:  "IDs of ssh keys to be placed as authorized_keys on the machine, example: [entrywan_sshkey.alice.id, entrywan_sshkey.bob.id].",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"sshkey", "sshkey_ids"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"os": {
-				Description: "The operating system image.  Choose alma, debian, fedora, rocky or ubuntu.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The operating system image.  Either a distribution such as alma, debian, fedora, rocky or ubuntu for its latest release, or a versioned image slug such as debian-12.  See the entrywan_images data source for available images.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"os", "snapshot_id"},
+			},
+			"snapshot_id": {
+				Description:  "The ID of a snapshot to boot from instead of an os image.  The snapshot must be in the same location.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"os", "snapshot_id"},
 			},
 			"userdata": {
 				Description: "Optional script to run on first boot.",
@@ -70,6 +97,73 @@ func instanceResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"ip6": {
+				Description: "Instance primary IPv6 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"backup_policy": {
+				Description: "Automatic platform backups of the instance's disk.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Description: "Whether backups are taken.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"schedule": {
+							Description:      "How often a backup is taken, either daily or weekly.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "daily",
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"daily", "weekly"}, false)),
+						},
+						"retention": {
+							Description:      "Number of backups to keep before the oldest is deleted.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          7,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 90)),
+						},
+					},
+				},
+			},
+			"backups": {
+				Description: "Backup points available for the instance, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The backup ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created": {
+							Description: "Time the backup was taken, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"size": {
+							Description: "Backup size in GB.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Description: "Optional labels for grouping and finding instances, example: [\"web\", \"prod\"].",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"vpcids": {
 				Description: "Optional VPCs to attach the instance to.",
 				Type:        schema.TypeList,
@@ -85,6 +179,7 @@ func instanceResource() *schema.Resource {
 type instanceCreateRes struct {
 	Id  string `json:"id"`
 	Ip4 string `json:"ip4"`
+	Ip6 string `json:"ip6"`
 }
 
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -96,6 +191,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 	os := d.Get("os").(string)
 	sshkey := d.Get("sshkey").(string)
 	userdata := d.Get("userdata").(string)
+	snapshotId := d.Get("snapshot_id").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	vpcIdsInt := d.Get("vpcids").([]interface{})
 	vpcIds := make([]string, len(vpcIdsInt))
 	for i, vpcIdInt := range vpcIdsInt {
@@ -103,6 +200,15 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 	}
 	var vpcIdsJson []byte
 	vpcIdsJson, _ = json.Marshal(vpcIds)
+	sshkeyIdsInt := d.Get("sshkey_ids").([]interface{})
+	sshkeyIds := make([]string, len(sshkeyIdsInt))
+	for i, sshkeyIdInt := range sshkeyIdsInt {
+		sshkeyIds[i] = sshkeyIdInt.(string)
+	}
+	var sshkeyIdsJson []byte
+	sshkeyIdsJson, _ = json.Marshal(sshkeyIds)
+	var tagsJson []byte
+	tagsJson, _ = json.Marshal(d.Get("tags").(*schema.Set).List())
 	client := http.Client{}
 	var jb []byte
 	if len(vpcIds) > 0 {
@@ -114,9 +220,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 	 "cpus": %d,
 	 "ram": %d,
 	 "os": "%s",
+	 "snapshotid": "%s",
 	 "sshkeyname": "%s",
-	 "userdata": %q}`,
-			hostname, string(vpcIdsJson), location, disk, cpus, ram, os, sshkey, userdata))
+	 "sshkeyids": %s,
+	 "tags": %s,
+	 "userdata": %q%s}`,
+			hostname, string(vpcIdsJson), location, disk, cpus, ram, os, snapshotId, sshkey, string(sshkeyIdsJson), string(tagsJson), userdata, projectJson))
 	} else {
 		jb = []byte(fmt.Sprintf(
 			`{"hostname": "%s",
@@ -125,9 +234,12 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 	 "cpus": %d,
 	 "ram": %d,
 	 "os": "%s",
+	 "snapshotid": "%s",
 	 "sshkeyname": "%s",
-	 "userdata": %q}`,
-			hostname, location, disk, cpus, ram, os, sshkey, userdata))
+	 "sshkeyids": %s,
+	 "tags": %s,
+	 "userdata": %q%s}`,
+			hostname, location, disk, cpus, ram, os, snapshotId, sshkey, string(sshkeyIdsJson), string(tagsJson), userdata, projectJson))
 	}
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/instance", br)
@@ -153,13 +265,79 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m any)
 
 	d.SetId(cr.Id)
 	d.Set("ip4", cr.Ip4)
+	d.Set("ip6", cr.Ip6)
+	if len(d.Get("backup_policy").([]any)) > 0 {
+		if diags := setInstanceBackupPolicy(d); diags != nil {
+			return diags
+		}
+	}
 	return resourceInstanceRead(ctx, d, m)
 }
 
+// setInstanceBackupPolicy applies the configured backup policy, or
+// disables backups when the backup_policy block is absent.
+func setInstanceBackupPolicy(d *schema.ResourceData) diag.Diagnostics {
+	id := d.Id()
+	enabled := false
+	schedule := "daily"
+	retention := 7
+	if policies := d.Get("backup_policy").([]any); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]any)
+		enabled = policy["enabled"].(bool)
+		schedule = policy["schedule"].(string)
+		retention = policy["retention"].(int)
+	}
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"enabled": %t, "schedule": "%s", "retention": %d}`, enabled, schedule, retention))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/instance/%s/backup", endpoint, id), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to set instance backup policy: %s", string(b))
+	}
+	return nil
+}
+
+type instanceBackup struct {
+	Id      string `json:"id"`
+	Created string `json:"created"`
+	Size    int    `json:"size"`
+}
+
+type instanceBackupPolicy struct {
+	Enabled   bool   `json:"enabled"`
+	Schedule  string `json:"schedule"`
+	Retention int    `json:"retention"`
+}
+
+type instanceBackupsRes struct {
+	Policy  *instanceBackupPolicy `json:"policy"`
+	Backups []instanceBackup      `json:"backups"`
+}
+
 type instanceGetRes struct {
-	State string `json:"state"`
-	Id    string `json:"id"`
-	Ip4   string `json:"ip4"`
+	State      string   `json:"state"`
+	Id         string   `json:"id"`
+	Hostname   string   `json:"hostname"`
+	Location   string   `json:"location"`
+	ProjectId  string   `json:"projectid"`
+	Ip4        string   `json:"ip4"`
+	Ip4Private []string `json:"ip4private"`
+	Ip6        string   `json:"ip6"`
+	Cpus       int      `json:"cpus"`
+	Ram        int      `json:"ram"`
+	Disk       int      `json:"disk"`
+	Os         string   `json:"os"`
+	Tags       []string `json:"tags"`
 }
 
 func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -186,10 +364,101 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m any) di
 	d.SetId(cr.Id)
 	d.Set("state", cr.State)
 	d.Set("ip4", ip4)
+	d.Set("ip6", cr.Ip6)
+	d.Set("tags", cr.Tags)
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	return readInstanceBackups(d)
+}
+
+// readInstanceBackups reads the backup policy and backup points of the
+// instance.  Failing to read them only warns, so the instance itself
+// can still be refreshed.
+func readInstanceBackups(d *schema.ResourceData) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/instance/"+id+"/backup", nil)
+	if err != nil {
+		return diag.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("unable to list instance backups: %v", err),
+		}}
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.Set("backup_policy", []map[string]any{})
+		d.Set("backups", []map[string]any{})
+		return nil
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("unable to list instance backups: %s", string(b)),
+		}}
+	}
+	var br instanceBackupsRes
+	err = json.Unmarshal(b, &br)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	policies := []map[string]any{}
+	if br.Policy != nil && (br.Policy.Enabled || len(d.Get("backup_policy").([]any)) > 0) {
+		policies = append(policies, map[string]any{
+			"enabled":   br.Policy.Enabled,
+			"schedule":  br.Policy.Schedule,
+			"retention": br.Policy.Retention,
+		})
+	}
+	d.Set("backup_policy", policies)
+	backups := make([]map[string]any, len(br.Backups))
+	for i, backup := range br.Backups {
+		backups[i] = map[string]any{
+			"id":      backup.Id,
+			"created": backup.Created,
+			"size":    backup.Size,
+		}
+	}
+	d.Set("backups", backups)
 	return nil
 }
 
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("backup_policy") {
+		if diags := setInstanceBackupPolicy(d); diags != nil {
+			return diags
+		}
+	}
+	if d.HasChange("tags") {
+		id := d.Id()
+		tagsJson, _ := json.Marshal(d.Get("tags").(*schema.Set).List())
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"tags": %s}`, tagsJson))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/instance/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update instance tags: %s", string(b))
+		}
+	}
 	return resourceInstanceRead(ctx, d, m)
 }
 
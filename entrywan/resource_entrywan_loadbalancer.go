@@ -19,6 +19,7 @@ func loadbalancerResource() *schema.Resource {
 		ReadContext:   resourceLoadbalancerRead,
 		UpdateContext: resourceLoadbalancerUpdate,
 		DeleteContext: resourceLoadbalancerDelete,
+		CustomizeDiff: validateLocation("loadbalancers"),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "A handy name for remembering which load balancer is which.",
@@ -30,6 +31,7 @@ func loadbalancerResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"algo": {
 				Description: "Load balancing algorithm to choose, either round-robin or least-used.",
 				Type:        schema.TypeString,
@@ -45,6 +47,11 @@ func loadbalancerResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"ip6": {
+				Description: "Load balancer primary IPv6 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"listeners": {
 				Description: "A listener for each port the load balancer should respond to traffic on.",
 				Required:    true,
@@ -90,16 +97,18 @@ func resourceLoadbalancerCreate(ctx context.Context, d *schema.ResourceData, m a
 	location := d.Get("location").(string)
 	algo := d.Get("algo").(string)
 	protocol := d.Get("protocol").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	listenersIface := d.Get("listeners").([]interface{})
 	listenersJson := []byte("[]")
 	listenersJson, _ = json.Marshal(listenersIface)
 	client := http.Client{}
-	jb := []byte(fmt.Sprintf(`{"name": "%s", "location": "%s", "algo": "%s", "protocol": "%s", "listeners": %s}`,
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "location": "%s", "algo": "%s", "protocol": "%s", "listeners": %s%s}`,
 		name,
 		location,
 		algo,
 		protocol,
-		listenersJson))
+		listenersJson,
+		projectJson))
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/loadbalancer", br)
 	if err != nil {
@@ -125,9 +134,26 @@ func resourceLoadbalancerCreate(ctx context.Context, d *schema.ResourceData, m a
 	return resourceLoadbalancerRead(ctx, d, m)
 }
 
+type loadbalancerTarget struct {
+	Port int    `json:"port"`
+	Ip   string `json:"ip"`
+}
+
+type loadbalancerListener struct {
+	Port    int                  `json:"port"`
+	Targets []loadbalancerTarget `json:"targets"`
+}
+
 type loadbalancerGetRes struct {
-	Id string `json:"id"`
-	Ip string `json:"ip"`
+	Id        string                 `json:"id"`
+	Name      string                 `json:"name"`
+	Location  string                 `json:"location"`
+	ProjectId string                 `json:"projectid"`
+	Algo      string                 `json:"algo"`
+	Protocol  string                 `json:"protocol"`
+	Ip        string                 `json:"ip"`
+	Ip6       string                 `json:"ip6"`
+	Listeners []loadbalancerListener `json:"listeners"`
 }
 
 func resourceLoadbalancerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -152,6 +178,10 @@ func resourceLoadbalancerRead(ctx context.Context, d *schema.ResourceData, m any
 	}
 	d.SetId(cr.Id)
 	d.Set("ip", cr.Ip)
+	d.Set("ip6", cr.Ip6)
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
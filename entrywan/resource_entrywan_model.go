@@ -7,8 +7,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -19,6 +21,10 @@ func modelResource() *schema.Resource {
 		ReadContext:   resourceModelRead,
 		UpdateContext: resourceModelUpdate,
 		DeleteContext: resourceModelDelete,
+		CustomizeDiff: customdiff.All(
+			resourceModelCustomizeDiff,
+			validateLocation("models"),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "A handy name for remembering which model is which.",
@@ -29,11 +35,14 @@ func modelResource() *schema.Resource {
 				Description: "The physical data center the model operates in.  us1 only during alpha.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
+			"project_id": projectIdSchema(),
 			"type": {
-				Description: "Model type.",
+				Description: "Model type.  See the entrywan_model_types data source for available types.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"state": {
 				Description: "Model state.",
@@ -44,6 +53,7 @@ func modelResource() *schema.Resource {
 				Description: "Model token.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 			},
 			"endpoint": {
 				Description: "Model endpoint.",
@@ -54,6 +64,27 @@ func modelResource() *schema.Resource {
 	}
 }
 
+// resourceModelCustomizeDiff checks the requested model type against
+// the types the API offers.
+func resourceModelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	if !d.HasChange("type") || !d.NewValueKnown("type") {
+		return nil
+	}
+	modelTypeName := d.Get("type").(string)
+	types, err := getModelTypes()
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		if t.Name == modelTypeName {
+			return nil
+		}
+		names[i] = t.Name
+	}
+	return fmt.Errorf("unknown model type %q, expected one of: %s", modelTypeName, strings.Join(names, ", "))
+}
+
 type modelCreateRes struct {
 	Id string `json:"id"`
 }
@@ -62,9 +93,10 @@ func resourceModelCreate(ctx context.Context, d *schema.ResourceData, m any) dia
 	name := d.Get("name").(string)
 	location := d.Get("location").(string)
 	modelType := d.Get("type").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	client := http.Client{}
 	var jb []byte
-	jb = []byte(fmt.Sprintf(`{"name": "%s", "location": "%s", "type": "%s"}`, name, location, modelType))
+	jb = []byte(fmt.Sprintf(`{"name": "%s", "location": "%s", "type": "%s"%s}`, name, location, modelType, projectJson))
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/model", br)
 	if err != nil {
@@ -91,10 +123,12 @@ func resourceModelCreate(ctx context.Context, d *schema.ResourceData, m any) dia
 }
 
 type modelGetRes struct {
-	State    string `json:"state"`
-	Id       string `json:"id"`
-	Endpoint string `json:"endpoint"`
-	Token    string `json:"token"`
+	State     string `json:"state"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	ProjectId string `json:"projectid"`
+	Endpoint  string `json:"endpoint"`
+	Token     string `json:"token"`
 }
 
 func resourceModelRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -118,13 +152,38 @@ func resourceModelRead(ctx context.Context, d *schema.ResourceData, m any) diag.
 		fmt.Printf("error unmarshaling request: %v", err)
 	}
 	d.SetId(cr.Id)
+	d.Set("name", cr.Name)
 	d.Set("state", cr.State)
 	d.Set("endpoint", cr.Endpoint)
 	d.Set("token", cr.Token)
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
 func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		id := d.Id()
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"name": "%s"}`, name))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/model/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to rename model: %s", string(b))
+		}
+	}
 	return resourceModelRead(ctx, d, m)
 }
 
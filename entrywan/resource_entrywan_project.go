@@ -0,0 +1,184 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func projectResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Project grouping resources for billing and access control, example: one project each for dev, stage and prod.  A project must be empty before it can be destroyed.  More information at https://www.entrywan.com/docs#projects",
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The project name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "What the project is for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// projectIdSchema returns the schema for the project_id argument shared
+// by resources that can be grouped into projects.
+func projectIdSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The ID of the entrywan_project the resource belongs to.  Defaults to the provider's default_project, or the account's default project when that is not set.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+	}
+}
+
+// resourceProjectId returns the project a resource is created in: its
+// project_id if set, otherwise the provider's default_project.
+func resourceProjectId(d *schema.ResourceData) string {
+	if projectId := d.Get("project_id").(string); projectId != "" {
+		return projectId
+	}
+	return defaultProject
+}
+
+// projectIdJson returns the projectid member to append to a create
+// request, or an empty string when no project is set so the account's
+// default project is used.
+func projectIdJson(projectId string) string {
+	if projectId == "" {
+		return ""
+	}
+	return fmt.Sprintf(`, "projectid": "%s"`, projectId)
+}
+
+type projectCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "description": %q}`, name, description))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/project", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create project: %s", string(b))
+	}
+	var cr projectCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceProjectRead(ctx, d, m)
+}
+
+type projectGetRes struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/project/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read project: %s", string(b))
+	}
+	var cr projectGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("name", cr.Name)
+	d.Set("description", cr.Description)
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChanges("name", "description") {
+		id := d.Id()
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"name": "%s", "description": %q}`, name, description))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/project/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update project: %s", string(b))
+		}
+	}
+	return resourceProjectRead(ctx, d, m)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/project/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete project: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,38 @@
+package entrywan
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceProjectId(t *testing.T) {
+	oldDefaultProject := defaultProject
+	defer func() { defaultProject = oldDefaultProject }()
+	cases := []struct {
+		name           string
+		projectId      string
+		defaultProject string
+		want           string
+		wantJson       string
+	}{
+		{"project_id set", "prj1", "prj2", "prj1", `, "projectid": "prj1"`},
+		{"default_project", "", "prj2", "prj2", `, "projectid": "prj2"`},
+		{"neither set", "", "", "", ""},
+	}
+	for _, c := range cases {
+		defaultProject = c.defaultProject
+		raw := map[string]any{}
+		if c.projectId != "" {
+			raw["project_id"] = c.projectId
+		}
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"project_id": projectIdSchema()}, raw)
+		got := resourceProjectId(d)
+		if got != c.want {
+			t.Errorf("%s: resourceProjectId = %q, want %q", c.name, got, c.want)
+		}
+		if gotJson := projectIdJson(got); gotJson != c.wantJson {
+			t.Errorf("%s: projectIdJson = %q, want %q", c.name, gotJson, c.wantJson)
+		}
+	}
+}
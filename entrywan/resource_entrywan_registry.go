@@ -0,0 +1,260 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// registryNamespaceRegexp matches a registry namespace: 2 to 63
+// lowercase letters, digits and hyphens.
+var registryNamespaceRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$`)
+
+func registryResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Private OCI container registry namespace.  Push images with the push credentials and deploy them with entrywan_app using the pull credentials.  More information at https://www.entrywan.com/docs#registry",
+		CreateContext: resourceRegistryCreate,
+		ReadContext:   resourceRegistryRead,
+		UpdateContext: resourceRegistryUpdate,
+		DeleteContext: resourceRegistryDelete,
+		CustomizeDiff: validateLocation("registries"),
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Description:      "The namespace images are pushed under, 2 to 63 lowercase letters, digits and hyphens.  Must be globally unique.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(registryNamespaceRegexp, "must be 2 to 63 lowercase letters, digits and hyphens")),
+			},
+			"location": {
+				Description: "The physical data center the registry is stored in.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"retention": {
+				Description: "Policy for deleting old images automatically.  Images are kept forever when absent.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keep_last": {
+							Description:      "Number of most recently pushed tags to keep per repository.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+						"untagged_days": {
+							Description:      "Number of days after which untagged images are deleted.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
+			"server": {
+				Description: "The registry hostname to log in to, example: us1.registry.entrywan.com.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"endpoint": {
+				Description: "The prefix for image references in the namespace, example: us1.registry.entrywan.com/myns.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"push_username": {
+				Description: "Username with push and pull access.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"push_password": {
+				Description: "Password with push and pull access.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"pull_username": {
+				Description: "Username with pull access only.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"pull_password": {
+				Description: "Password with pull access only.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// registryRetentionJson returns the configured retention policy as
+// JSON, or null when none is set.
+func registryRetentionJson(d *schema.ResourceData) string {
+	retentions := d.Get("retention").([]any)
+	if len(retentions) == 0 || retentions[0] == nil {
+		return "null"
+	}
+	retention := retentions[0].(map[string]any)
+	retentionJson, _ := json.Marshal(registryRetention{
+		KeepLast:     retention["keep_last"].(int),
+		UntaggedDays: retention["untagged_days"].(int),
+	})
+	return string(retentionJson)
+}
+
+type registryCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceRegistryCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	namespace := d.Get("namespace").(string)
+	location := d.Get("location").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(
+		`{"namespace": "%s",
+ "location": "%s",
+ "retention": %s}`,
+		namespace, location, registryRetentionJson(d)))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/registry", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create registry: %s", string(b))
+	}
+	var cr registryCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceRegistryRead(ctx, d, m)
+}
+
+type registryRetention struct {
+	KeepLast     int `json:"keeplast"`
+	UntaggedDays int `json:"untaggeddays"`
+}
+
+type registryGetRes struct {
+	Id           string             `json:"id"`
+	Namespace    string             `json:"namespace"`
+	Location     string             `json:"location"`
+	Retention    *registryRetention `json:"retention"`
+	Server       string             `json:"server"`
+	Endpoint     string             `json:"endpoint"`
+	PushUsername string             `json:"pushusername"`
+	PushPassword string             `json:"pushpassword"`
+	PullUsername string             `json:"pullusername"`
+	PullPassword string             `json:"pullpassword"`
+}
+
+func resourceRegistryRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/registry/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read registry: %s", string(b))
+	}
+	var cr registryGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	retention := []map[string]any{}
+	if cr.Retention != nil {
+		retention = append(retention, map[string]any{
+			"keep_last":     cr.Retention.KeepLast,
+			"untagged_days": cr.Retention.UntaggedDays,
+		})
+	}
+	d.Set("namespace", cr.Namespace)
+	d.Set("location", cr.Location)
+	d.Set("retention", retention)
+	d.Set("server", cr.Server)
+	d.Set("endpoint", cr.Endpoint)
+	d.Set("push_username", cr.PushUsername)
+	d.Set("push_password", cr.PushPassword)
+	d.Set("pull_username", cr.PullUsername)
+	d.Set("pull_password", cr.PullPassword)
+	return nil
+}
+
+func resourceRegistryUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("retention") {
+		id := d.Id()
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"retention": %s}`, registryRetentionJson(d)))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/registry/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to update registry: %s", string(b))
+		}
+	}
+	return resourceRegistryRead(ctx, d, m)
+}
+
+func resourceRegistryDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/registry/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete registry: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,50 @@
+package entrywan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRegistryNamespaceRegexp(t *testing.T) {
+	cases := []struct {
+		namespace string
+		valid     bool
+	}{
+		{"myorg", true},
+		{"my-org", true},
+		{"ab", true},
+		{strings.Repeat("a", 63), true},
+		{"a", false},
+		{strings.Repeat("a", 64), false},
+		{"MyOrg", false},
+		{"my.org", false},
+		{"-myorg", false},
+	}
+	for _, c := range cases {
+		if got := registryNamespaceRegexp.MatchString(c.namespace); got != c.valid {
+			t.Errorf("registryNamespaceRegexp.MatchString(%q) = %t, want %t", c.namespace, got, c.valid)
+		}
+	}
+}
+
+func TestRegistryRetentionJson(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]any
+		want string
+	}{
+		{"none", map[string]any{}, "null"},
+		{"keep last", map[string]any{"retention": []any{map[string]any{"keep_last": 10}}}, `{"keeplast":10,"untaggeddays":0}`},
+		{"all set", map[string]any{"retention": []any{map[string]any{"keep_last": 10, "untagged_days": 7}}}, `{"keeplast":10,"untaggeddays":7}`},
+	}
+	for _, c := range cases {
+		c.raw["namespace"] = "myorg"
+		c.raw["location"] = "us1"
+		d := schema.TestResourceDataRaw(t, registryResource().Schema, c.raw)
+		if got := registryRetentionJson(d); got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
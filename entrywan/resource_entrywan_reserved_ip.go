@@ -0,0 +1,174 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func reservedIpResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Static IPv4 address that survives instance replacement.  Point it at an instance or load balancer with entrywan_reserved_ip_assignment.  More information at https://www.entrywan.com/docs#reservedips",
+		CreateContext: resourceReservedIpCreate,
+		ReadContext:   resourceReservedIpRead,
+		UpdateContext: resourceReservedIpUpdate,
+		DeleteContext: resourceReservedIpDelete,
+		CustomizeDiff: validateLocation("instances"),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "A handy name for remembering which address is which.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"location": {
+				Description: "The physical data center the address is routed in.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip4": {
+				Description: "The reserved IPv4 address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type reservedIpCreateRes struct {
+	Id  string `json:"id"`
+	Ip4 string `json:"ip4"`
+}
+
+func resourceReservedIpCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "location": "%s"}`, name, location))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/reservedip", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to reserve ip: %s", string(b))
+	}
+	var cr reservedIpCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceReservedIpRead(ctx, d, m)
+}
+
+type reservedIpGetRes struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	Location       string `json:"location"`
+	Ip4            string `json:"ip4"`
+	InstanceId     string `json:"instanceid"`
+	LoadbalancerId string `json:"loadbalancerid"`
+}
+
+// getReservedIp fetches a single reserved address, returning nil if it
+// no longer exists.
+func getReservedIp(id string) (*reservedIpGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/reservedip/"+id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to read reserved ip: %s", string(b))
+	}
+	var cr reservedIpGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return &cr, nil
+}
+
+func resourceReservedIpRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	cr, err := getReservedIp(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cr == nil {
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", cr.Name)
+	d.Set("location", cr.Location)
+	d.Set("ip4", cr.Ip4)
+	return nil
+}
+
+func resourceReservedIpUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		id := d.Id()
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"name": "%s"}`, name))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/reservedip/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to rename reserved ip: %s", string(b))
+		}
+	}
+	return resourceReservedIpRead(ctx, d, m)
+}
+
+func resourceReservedIpDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/reservedip/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
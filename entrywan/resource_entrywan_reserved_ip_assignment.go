@@ -0,0 +1,115 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func reservedIpAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Routes a reserved IPv4 address to an instance or load balancer.  Changing the target moves the address in place without releasing it.  More information at https://www.entrywan.com/docs#reservedips",
+		CreateContext: resourceReservedIpAssignmentCreate,
+		ReadContext:   resourceReservedIpAssignmentRead,
+		UpdateContext: resourceReservedIpAssignmentUpdate,
+		DeleteContext: resourceReservedIpAssignmentDelete,
+		Schema: map[string]*schema.Schema{
+			"reserved_ip_id": {
+				Description: "The ID of the reserved address to assign.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Description:  "The ID of the instance to route the address to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance_id", "loadbalancer_id"},
+			},
+			"loadbalancer_id": {
+				Description:  "The ID of the load balancer to route the address to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance_id", "loadbalancer_id"},
+			},
+		},
+	}
+}
+
+// assignReservedIp routes the reserved address to the configured
+// instance or load balancer, replacing any previous target.
+func assignReservedIp(d *schema.ResourceData) diag.Diagnostics {
+	reservedIpId := d.Get("reserved_ip_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	loadbalancerId := d.Get("loadbalancer_id").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"instanceid": "%s", "loadbalancerid": "%s"}`, instanceId, loadbalancerId))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/reservedip/%s/assign", endpoint, reservedIpId), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to assign reserved ip: %s", string(b))
+	}
+	return nil
+}
+
+func resourceReservedIpAssignmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if diags := assignReservedIp(d); diags != nil {
+		return diags
+	}
+	d.SetId(d.Get("reserved_ip_id").(string))
+	return resourceReservedIpAssignmentRead(ctx, d, m)
+}
+
+func resourceReservedIpAssignmentRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	cr, err := getReservedIp(d.Get("reserved_ip_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cr == nil || (cr.InstanceId == "" && cr.LoadbalancerId == "") {
+		d.SetId("")
+		return nil
+	}
+	d.Set("instance_id", cr.InstanceId)
+	d.Set("loadbalancer_id", cr.LoadbalancerId)
+	return nil
+}
+
+func resourceReservedIpAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChanges("instance_id", "loadbalancer_id") {
+		if diags := assignReservedIp(d); diags != nil {
+			return diags
+		}
+	}
+	return resourceReservedIpAssignmentRead(ctx, d, m)
+}
+
+func resourceReservedIpAssignmentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	reservedIpId := d.Get("reserved_ip_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/reservedip/%s/unassign", endpoint, reservedIpId), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
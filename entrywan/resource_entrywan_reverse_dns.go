@@ -0,0 +1,133 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func reverseDnsResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Reverse DNS (PTR) record for an instance or reserved IP address.  The address reverts to its default PTR record when destroyed.  More information at https://www.entrywan.com/docs#dns",
+		CreateContext: resourceReverseDnsCreate,
+		ReadContext:   resourceReverseDnsRead,
+		UpdateContext: resourceReverseDnsUpdate,
+		DeleteContext: resourceReverseDnsDelete,
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Description:      "The IPv4 or IPv6 address, example: entrywan_instance.web.ip4.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsIPAddress),
+			},
+			"hostname": {
+				Description:      "The fully qualified hostname the address resolves to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(hostnameRegexp, "must be a fully qualified hostname")),
+			},
+		},
+	}
+}
+
+// putReverseDns sets the PTR record of the address to hostname.
+func putReverseDns(ip string, hostname string) diag.Diagnostics {
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"hostname": "%s"}`, hostname))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/ptr/%s", endpoint, ip), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to set reverse dns: %s", string(b))
+	}
+	return nil
+}
+
+func resourceReverseDnsCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	ip := d.Get("ip").(string)
+	hostname := d.Get("hostname").(string)
+	if diags := putReverseDns(ip, hostname); diags != nil {
+		return diags
+	}
+	d.SetId(ip)
+	return resourceReverseDnsRead(ctx, d, m)
+}
+
+type reverseDnsGetRes struct {
+	Ip       string `json:"ip"`
+	Hostname string `json:"hostname"`
+}
+
+func resourceReverseDnsRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	ip := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/ptr/"+ip, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read reverse dns: %s", string(b))
+	}
+	var cr reverseDnsGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.Set("ip", ip)
+	d.Set("hostname", cr.Hostname)
+	return nil
+}
+
+func resourceReverseDnsUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("hostname") {
+		if diags := putReverseDns(d.Id(), d.Get("hostname").(string)); diags != nil {
+			return diags
+		}
+	}
+	return resourceReverseDnsRead(ctx, d, m)
+}
+
+func resourceReverseDnsDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	ip := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/ptr/%s", endpoint, ip), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
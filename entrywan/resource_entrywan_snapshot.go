@@ -0,0 +1,190 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func snapshotResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Point in time copy of a compute instance's disk.  New instances can boot from it by setting snapshot_id.  More information at https://www.entrywan.com/docs#snapshots",
+		CreateContext: resourceSnapshotCreate,
+		ReadContext:   resourceSnapshotRead,
+		DeleteContext: resourceSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "A handy name for remembering which snapshot is which.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Description: "The ID of the instance whose disk is captured.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"location": {
+				Description: "The physical data center the snapshot is stored in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"size": {
+				Description: "Snapshot size in GB.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"state": {
+				Description: "Snapshot state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created": {
+				Description: "Time the snapshot was taken, in RFC 3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type snapshotCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	instanceId := d.Get("instance_id").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"name": "%s", "instanceid": "%s"}`, name, instanceId))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/snapshot", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create snapshot: %s", string(b))
+	}
+	var cr snapshotCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	conf := &retry.StateChangeConf{
+		Pending: []string{"pending", "creating"},
+		Target:  []string{"available"},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   5 * time.Second,
+		Refresh: func() (any, string, error) {
+			sr, err := getSnapshot(cr.Id)
+			if err != nil {
+				return nil, "", err
+			}
+			if sr == nil {
+				return nil, "", fmt.Errorf("snapshot %s not found", cr.Id)
+			}
+			return sr, sr.State, nil
+		},
+	}
+	_, err = conf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("error waiting for snapshot: %v", err)
+	}
+	return resourceSnapshotRead(ctx, d, m)
+}
+
+type snapshotGetRes struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	InstanceId string `json:"instanceid"`
+	Location   string `json:"location"`
+	Size       int    `json:"size"`
+	State      string `json:"state"`
+	Created    string `json:"created"`
+}
+
+// getSnapshot fetches a single snapshot, returning nil if it no longer
+// exists.
+func getSnapshot(id string) (*snapshotGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/snapshot/"+id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to read snapshot: %s", string(b))
+	}
+	var cr snapshotGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return &cr, nil
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	cr, err := getSnapshot(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cr == nil {
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", cr.Name)
+	d.Set("location", cr.Location)
+	d.Set("size", cr.Size)
+	d.Set("state", cr.State)
+	d.Set("created", cr.Created)
+	return nil
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/snapshot/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	_, err = client.Do(req)
+	if err != nil {
+		fmt.Printf("error making request: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/ssh"
 )
 
 func sshkeyResource() *schema.Resource {
@@ -26,14 +28,83 @@ func sshkeyResource() *schema.Resource {
 				Optional:    true,
 			},
 			"pub": {
-				Description: "The public key in authorized_keys format.",
+				Description:      "The public key in authorized_keys format.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateSshkeyPub,
+				DiffSuppressFunc: suppressSshkeyPubDiff,
+			},
+			"algorithm": {
+				Description: "The key algorithm, one of rsa, dsa, ecdsa or ed25519.",
 				Type:        schema.TypeString,
-				Optional:    true,
+				Computed:    true,
+			},
+			"fingerprint": {
+				Description: "The SHA256 fingerprint of the key, as printed by ssh-keygen -l.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"fingerprint_md5": {
+				Description: "The legacy MD5 fingerprint of the key, as printed by ssh-keygen -l -E md5.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 		},
 	}
 }
 
+// sshkeyAlgorithms maps the ssh wire format key types accepted by the
+// API to the algorithm names used in the documentation.
+var sshkeyAlgorithms = map[string]string{
+	ssh.KeyAlgoRSA:      "rsa",
+	ssh.KeyAlgoDSA:      "dsa",
+	ssh.KeyAlgoECDSA256: "ecdsa",
+	ssh.KeyAlgoECDSA384: "ecdsa",
+	ssh.KeyAlgoECDSA521: "ecdsa",
+	ssh.KeyAlgoED25519:  "ed25519",
+}
+
+// parseSshkeyPub parses a public key in authorized_keys format and
+// returns it along with its algorithm name.
+func parseSshkeyPub(pub string) (ssh.PublicKey, string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(pub))
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to parse public key: %v", err)
+	}
+	algorithm, ok := sshkeyAlgorithms[key.Type()]
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported key type %s, expected rsa, dsa, ecdsa or ed25519", key.Type())
+	}
+	return key, algorithm, nil
+}
+
+func validateSshkeyPub(v any, path cty.Path) diag.Diagnostics {
+	_, _, err := parseSshkeyPub(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       err.Error(),
+			AttributePath: path,
+		}}
+	}
+	return nil
+}
+
+// suppressSshkeyPubDiff ignores differences in the key comment and
+// surrounding whitespace, so only a different key forces replacement.
+func suppressSshkeyPubDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldKey, _, err := parseSshkeyPub(old)
+	if err != nil {
+		return false
+	}
+	newKey, _, err := parseSshkeyPub(new)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(oldKey.Marshal(), newKey.Marshal())
+}
+
 type sshkeyCreateRes struct {
 	Id string `json:"id"`
 }
@@ -68,11 +139,73 @@ func resourceSshkeyCreate(ctx context.Context, d *schema.ResourceData, m any) di
 	return resourceSshkeyRead(ctx, d, m)
 }
 
+type sshkeyGetRes struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Pub  string `json:"pub"`
+}
+
 func resourceSshkeyRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/sshkey/"+id, nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read sshkey: %s", string(b))
+	}
+	var cr sshkeyGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling response: %v", err)
+	}
+	d.Set("name", cr.Name)
+	d.Set("pub", cr.Pub)
+	key, algorithm, err := parseSshkeyPub(cr.Pub)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("algorithm", algorithm)
+	d.Set("fingerprint", ssh.FingerprintSHA256(key))
+	d.Set("fingerprint_md5", ssh.FingerprintLegacyMD5(key))
 	return nil
 }
 
 func resourceSshkeyUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if d.HasChange("name") {
+		id := d.Id()
+		name := d.Get("name").(string)
+		client := http.Client{}
+		jb := []byte(fmt.Sprintf(`{"name": "%s"}`, name))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/sshkey/%s", endpoint, id), br)
+		if err != nil {
+			return diag.Errorf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to rename sshkey: %s", string(b))
+		}
+	}
 	return resourceSshkeyRead(ctx, d, m)
 }
 
@@ -81,7 +214,7 @@ func resourceSshkeyDelete(ctx context.Context, d *schema.ResourceData, m any) di
 	client := http.Client{}
 	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/sshkey/%s", endpoint, id), nil)
 	if err != nil {
-		fmt.Print("error forming request: %v", err)
+		fmt.Printf("error forming request: %v", err)
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	_, err = client.Do(req)
@@ -0,0 +1,231 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func volumeResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Block storage volume that outlives the instances it is attached to.  Attach it with entrywan_volume_attachment.  More information at https://www.entrywan.com/docs#volumes",
+		CreateContext: resourceVolumeCreate,
+		ReadContext:   resourceVolumeRead,
+		UpdateContext: resourceVolumeUpdate,
+		DeleteContext: resourceVolumeDelete,
+		CustomizeDiff: customdiff.All(
+			validateLocation("volumes"),
+			customdiff.ValidateChange("size", func(ctx context.Context, old, new, m any) error {
+				if new.(int) < old.(int) {
+					return fmt.Errorf("volume size cannot shrink from %d GB to %d GB", old.(int), new.(int))
+				}
+				return nil
+			}),
+		),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "A handy name for remembering which volume is which.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"location": {
+				Description: "The physical data center the volume operates in.  Must match the location of the instance it is attached to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"size": {
+				Description:      "Volume size in GB.  Can be grown while attached, but not shrunk.",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"filesystem": {
+				Description:      "The filesystem the volume is formatted with, either ext4 or xfs.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "ext4",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"ext4", "xfs"}, false)),
+			},
+			"state": {
+				Description: "Volume state.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type volumeCreateRes struct {
+	Id string `json:"id"`
+}
+
+func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	size := d.Get("size").(int)
+	filesystem := d.Get("filesystem").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(
+		`{"name": "%s",
+ "location": "%s",
+ "size": %d,
+ "filesystem": "%s"}`,
+		name, location, size, filesystem))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("POST", endpoint+"/volume", br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	var b []byte
+	b, err = ioutil.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to create volume: %s", string(b))
+	}
+	var cr volumeCreateRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		fmt.Printf("error unmarshaling request: %v", err)
+	}
+	d.SetId(cr.Id)
+	return resourceVolumeRead(ctx, d, m)
+}
+
+type volumeGetRes struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Location   string `json:"location"`
+	Size       int    `json:"size"`
+	Filesystem string `json:"filesystem"`
+	State      string `json:"state"`
+	InstanceId string `json:"instanceid"`
+	Device     string `json:"device"`
+}
+
+// getVolume fetches a single volume, returning nil if it no longer
+// exists.
+func getVolume(id string) (*volumeGetRes, error) {
+	client := http.Client{}
+	req, err := http.NewRequest("GET", endpoint+"/volume/"+id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("unable to read volume: %s", string(b))
+	}
+	var cr volumeGetRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %v", err)
+	}
+	return &cr, nil
+}
+
+func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	cr, err := getVolume(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cr == nil {
+		d.SetId("")
+		return nil
+	}
+	d.Set("name", cr.Name)
+	d.Set("location", cr.Location)
+	d.Set("size", cr.Size)
+	d.Set("filesystem", cr.Filesystem)
+	d.Set("state", cr.State)
+	return nil
+}
+
+func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		jb := []byte(fmt.Sprintf(`{"name": "%s"}`, name))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/volume/%s", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to rename volume: %s", string(b))
+		}
+	}
+	if d.HasChange("size") {
+		size := d.Get("size").(int)
+		jb := []byte(fmt.Sprintf(`{"size": %d}`, size))
+		br := bytes.NewReader(jb)
+		req, err := http.NewRequest("PUT", fmt.Sprintf("%s/volume/%s/resize", endpoint, id), br)
+		if err != nil {
+			fmt.Printf("error forming request: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		res, err := client.Do(req)
+		if err != nil {
+			return diag.Errorf("error making request: %v", err)
+		}
+		if res.StatusCode != 200 {
+			b, _ := ioutil.ReadAll(res.Body)
+			return diag.Errorf("unable to resize volume: %s", string(b))
+		}
+	}
+	return resourceVolumeRead(ctx, d, m)
+}
+
+func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/volume/%s", endpoint, id), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 && res.StatusCode != http.StatusNotFound {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to delete volume: %s", string(b))
+	}
+	d.SetId("")
+	return nil
+}
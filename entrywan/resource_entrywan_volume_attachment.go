@@ -0,0 +1,139 @@
+package entrywan
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func volumeAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Attaches a block storage volume to a compute instance.  The volume is detached before either it or the instance is destroyed.  More information at https://www.entrywan.com/docs#volumes",
+		CreateContext: resourceVolumeAttachmentCreate,
+		ReadContext:   resourceVolumeAttachmentRead,
+		DeleteContext: resourceVolumeAttachmentDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"volume_id": {
+				Description: "The ID of the volume to attach.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_id": {
+				Description: "The ID of the instance to attach the volume to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"device": {
+				Description: "The device path the volume appears at on the instance, example: /dev/vdb.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// waitVolumeState polls the volume until it reaches target.
+func waitVolumeState(ctx context.Context, id string, pending []string, target string, timeout time.Duration) error {
+	conf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Timeout: timeout,
+		Delay:   2 * time.Second,
+		Refresh: func() (any, string, error) {
+			cr, err := getVolume(id)
+			if err != nil {
+				return nil, "", err
+			}
+			if cr == nil {
+				return nil, "", fmt.Errorf("volume %s not found", id)
+			}
+			return cr, cr.State, nil
+		},
+	}
+	_, err := conf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	volumeId := d.Get("volume_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	client := http.Client{}
+	jb := []byte(fmt.Sprintf(`{"instanceid": "%s"}`, instanceId))
+	br := bytes.NewReader(jb)
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/volume/%s/attach", endpoint, volumeId), br)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to attach volume: %s", string(b))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", volumeId, instanceId))
+	err = waitVolumeState(ctx, volumeId, []string{"available", "attaching"}, "attached", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for volume to attach: %v", err)
+	}
+	return resourceVolumeAttachmentRead(ctx, d, m)
+}
+
+func resourceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	volumeId := d.Get("volume_id").(string)
+	instanceId := d.Get("instance_id").(string)
+	cr, err := getVolume(volumeId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if cr == nil || cr.InstanceId != instanceId {
+		d.SetId("")
+		return nil
+	}
+	d.Set("device", cr.Device)
+	return nil
+}
+
+func resourceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	volumeId := d.Get("volume_id").(string)
+	client := http.Client{}
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/volume/%s/detach", endpoint, volumeId), nil)
+	if err != nil {
+		fmt.Printf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	if res.StatusCode != 200 {
+		b, _ := ioutil.ReadAll(res.Body)
+		return diag.Errorf("unable to detach volume: %s", string(b))
+	}
+	err = waitVolumeState(ctx, volumeId, []string{"attached", "detaching"}, "available", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for volume to detach: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,73 @@
+package entrywan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVolumeSizeCannotShrink(t *testing.T) {
+	state := map[string]string{"name": "data", "location": "us1", "size": "20", "filesystem": "ext4"}
+	cases := []struct {
+		size    int
+		wantErr bool
+	}{
+		{20, false},
+		{40, false},
+		{10, true},
+	}
+	for _, c := range cases {
+		raw := map[string]any{"name": "data", "location": "us1", "size": c.size}
+		err := testResourceDiff(volumeResource(), state, raw)
+		if (err != nil) != c.wantErr {
+			t.Errorf("size 20 to %d: got error %v, want error %t", c.size, err, c.wantErr)
+		}
+	}
+}
+
+func TestWaitVolumeState(t *testing.T) {
+	states := []string{"attaching", "attached"}
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/volume/vol1":
+			state := states[len(states)-1]
+			if polls < len(states) {
+				state = states[polls]
+			}
+			polls++
+			json.NewEncoder(w).Encode(volumeGetRes{Id: "vol1", State: state})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	oldEndpoint := endpoint
+	endpoint = server.URL
+	defer func() { endpoint = oldEndpoint }()
+
+	cases := []struct {
+		name    string
+		id      string
+		pending []string
+		target  string
+		wantErr string
+	}{
+		{"reaches target", "vol1", []string{"attaching"}, "attached", ""},
+		{"volume gone", "vol2", []string{"attaching"}, "attached", "volume vol2 not found"},
+	}
+	for _, c := range cases {
+		polls = 0
+		err := waitVolumeState(context.Background(), c.id, c.pending, c.target, time.Minute)
+		if c.wantErr == "" && err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+		}
+		if c.wantErr != "" && (err == nil || !strings.Contains(err.Error(), c.wantErr)) {
+			t.Errorf("%s: got error %v, want %q", c.name, err, c.wantErr)
+		}
+	}
+}
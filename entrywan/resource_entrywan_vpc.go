@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func vpcResource() *schema.Resource {
@@ -26,11 +28,19 @@ func vpcResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"project_id": projectIdSchema(),
 			"prefix": {
 				Description: "The CIDR prefix of the network.  Example: 192.168.5.0/24",
 				Required:    true,
 				Type:        schema.TypeString,
 			},
+			"prefix6": {
+				Description:      "Optional IPv6 CIDR prefix of the network, giving members dual-stack private addresses.  Example: fd00:5::/64",
+				Optional:         true,
+				ForceNew:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validateIpv6Cidr),
+			},
 			"members": {
 				Description: "The initial members of the VPC.",
 				Optional:    true,
@@ -62,8 +72,15 @@ type vpcCreateRes struct {
 func resourceVpcCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	name := d.Get("name").(string)
 	prefix := d.Get("prefix").(string)
+	prefix6 := d.Get("prefix6").(string)
+	projectJson := projectIdJson(resourceProjectId(d))
 	client := http.Client{}
-	jb := []byte(fmt.Sprintf(`{"name": "%s", "prefix": "%s"}`, name, prefix))
+	var jb []byte
+	if prefix6 == "" {
+		jb = []byte(fmt.Sprintf(`{"name": "%s", "prefix": "%s"%s}`, name, prefix, projectJson))
+	} else {
+		jb = []byte(fmt.Sprintf(`{"name": "%s", "prefix": "%s", "prefix6": "%s"%s}`, name, prefix, prefix6, projectJson))
+	}
 	br := bytes.NewReader(jb)
 	req, err := http.NewRequest("POST", endpoint+"/vpc", br)
 	if err != nil {
@@ -110,18 +127,59 @@ func resourceVpcCreate(ctx context.Context, d *schema.ResourceData, m any) diag.
 	return resourceVpcRead(ctx, d, m)
 }
 
+// validateIpv6Cidr checks that a value is an IPv6 CIDR block.
+func validateIpv6Cidr(v any, k string) ([]string, []error) {
+	ip, _, err := net.ParseCIDR(v.(string))
+	if err != nil || ip.To4() != nil {
+		return nil, []error{fmt.Errorf("expected %s to be an IPv6 CIDR block, got: %s", k, v)}
+	}
+	return nil, nil
+}
+
 func resourceVpcRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	id := d.Id()
+	client := http.Client{}
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/vpc/%s", endpoint, id), nil)
+	if err != nil {
+		return diag.Errorf("error forming request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return diag.Errorf("error making request: %v", err)
+	}
+	if res.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	b, err := io.ReadAll(res.Body)
+	if res.StatusCode != 200 {
+		return diag.Errorf("unable to read vpc: %s", string(b))
+	}
+	var cr vpcRes
+	err = json.Unmarshal(b, &cr)
+	if err != nil {
+		return diag.Errorf("error unmarshaling response: %v", err)
+	}
+	if cr.ProjectId != "" {
+		d.Set("project_id", cr.ProjectId)
+	}
 	return nil
 }
 
 type vpcmember struct {
-	Ippublic  string `json: "ippublic"`
-	Ipprivate string `json: "ipprivate"`
+	Ippublic  string `json:"ippublic"`
+	Ipprivate string `json:"ipprivate"`
 }
 
 type vpcRes struct {
-	Id      string      `json: "id"`
-	Members []vpcmember `json: "members"`
+	Id        string      `json:"id"`
+	Name      string      `json:"name"`
+	Prefix    string      `json:"prefix"`
+	Prefix6   string      `json:"prefix6"`
+	ProjectId string      `json:"projectid"`
+	Members   []vpcmember `json:"members"`
 }
 
 func resourceVpcUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
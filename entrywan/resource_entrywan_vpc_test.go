@@ -0,0 +1,58 @@
+package entrywan
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateIpv6Cidr(t *testing.T) {
+	cases := []struct {
+		cidr  string
+		valid bool
+	}{
+		{"fd00:5::/64", true},
+		{"2001:db8::/32", true},
+		{"fd00::1/128", true},
+		{"10.0.0.0/8", false},
+		{"::ffff:10.0.0.0/104", false},
+		{"fd00:5::", false},
+		{"not a prefix", false},
+	}
+	for _, c := range cases {
+		_, errs := validateIpv6Cidr(c.cidr, "prefix6")
+		if got := len(errs) == 0; got != c.valid {
+			t.Errorf("validateIpv6Cidr(%q) valid = %t, want %t", c.cidr, got, c.valid)
+		}
+	}
+}
+
+func TestResourceVpcRead(t *testing.T) {
+	testServeJson(t, map[string]any{
+		"/vpc/vpc1": vpcRes{Id: "vpc1", ProjectId: "prj1"},
+		"/vpc/vpc2": vpcRes{Id: "vpc2"},
+	})
+	cases := []struct {
+		id            string
+		wantId        string
+		wantProjectId string
+	}{
+		{"vpc1", "vpc1", "prj1"},
+		{"vpc2", "vpc2", "prj9"},
+		{"vpc3", "", "prj9"},
+	}
+	for _, c := range cases {
+		d := vpcResource().TestResourceData()
+		d.SetId(c.id)
+		d.Set("project_id", "prj9")
+		if diags := resourceVpcRead(context.Background(), d, nil); diags.HasError() {
+			t.Errorf("%s: unexpected error: %v", c.id, diags)
+			continue
+		}
+		if d.Id() != c.wantId {
+			t.Errorf("%s: id = %q, want %q", c.id, d.Id(), c.wantId)
+		}
+		if got := d.Get("project_id").(string); got != c.wantProjectId {
+			t.Errorf("%s: project_id = %q, want %q", c.id, got, c.wantProjectId)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+data "entrywan_account" "current" {}
+
+resource "entrywan_instance" "workers" {
+  count      = 3
+  hostname   = "worker-${count.index}"
+  location   = "us1"
+  disk       = 20
+  cpus       = 2
+  ram        = 4
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = "debian"
+
+  lifecycle {
+    precondition {
+      condition     = data.entrywan_account.current.quotas["vcpus"] >= 6
+      error_message = "The account vCPU quota is too small for 3 workers."
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "entrywan_app" "nginx" {
+  name = "my-nginx-worker"
+}
+
+output "nginx_url" {
+  value = data.entrywan_app.nginx.url
+}
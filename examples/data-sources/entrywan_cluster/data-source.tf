@@ -0,0 +1,7 @@
+data "entrywan_cluster" "platform" {
+  name = "mycluster"
+}
+
+output "apiserver" {
+  value = data.entrywan_cluster.platform.apiserver
+}
@@ -0,0 +1,7 @@
+data "entrywan_firewall" "http" {
+  name = "http"
+}
+
+output "http_ports" {
+  value = data.entrywan_firewall.http.rules[*].port
+}
@@ -0,0 +1,13 @@
+data "entrywan_images" "debian" {
+  distro = "debian"
+}
+
+resource "entrywan_instance" "castula" {
+  hostname   = "castula"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = data.entrywan_images.debian.images[0].slug
+}
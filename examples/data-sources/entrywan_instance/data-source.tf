@@ -0,0 +1,7 @@
+data "entrywan_instance" "bastion" {
+  hostname = "bastion"
+}
+
+output "bastion_ip4" {
+  value = data.entrywan_instance.bastion.ip4
+}
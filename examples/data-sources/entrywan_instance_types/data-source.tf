@@ -0,0 +1,11 @@
+data "entrywan_instance_types" "us1" {
+  location = "us1"
+}
+
+locals {
+  smallest = [for t in data.entrywan_instance_types.us1.instance_types : t if t.cpus == 1][0]
+}
+
+output "smallest_monthly_price" {
+  value = local.smallest.price_monthly
+}
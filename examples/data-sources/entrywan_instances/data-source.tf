@@ -0,0 +1,9 @@
+data "entrywan_instances" "web" {
+  location = "us1"
+  state    = "running"
+  tag      = "web"
+}
+
+output "web_ip4s" {
+  value = data.entrywan_instances.web.instances[*].ip4
+}
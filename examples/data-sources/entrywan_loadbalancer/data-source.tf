@@ -0,0 +1,7 @@
+data "entrywan_loadbalancer" "ingress" {
+  name = "myloadbalancer"
+}
+
+output "ingress_ip" {
+  value = data.entrywan_loadbalancer.ingress.ip
+}
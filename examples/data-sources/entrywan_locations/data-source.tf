@@ -0,0 +1,5 @@
+data "entrywan_locations" "all" {}
+
+output "cluster_locations" {
+  value = [for l in data.entrywan_locations.all.locations : l.slug if contains(l.services, "clusters")]
+}
@@ -0,0 +1,5 @@
+data "entrywan_model_types" "all" {}
+
+output "model_type_names" {
+  value = data.entrywan_model_types.all.types[*].name
+}
@@ -0,0 +1,14 @@
+data "entrywan_snapshots" "golden" {
+  name_prefix = "golden-"
+  location    = "us1"
+}
+
+resource "entrywan_instance" "web" {
+  hostname    = "web"
+  location    = "us1"
+  disk        = 20
+  cpus        = 1
+  ram         = 2
+  sshkey_ids  = [entrywan_sshkey.mysshkey.id]
+  snapshot_id = data.entrywan_snapshots.golden.snapshots[0].id
+}
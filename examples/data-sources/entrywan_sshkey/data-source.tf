@@ -0,0 +1,13 @@
+data "entrywan_sshkey" "shared" {
+  name = "mysshkey"
+}
+
+resource "entrywan_instance" "castula" {
+  hostname   = "castula"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [data.entrywan_sshkey.shared.id]
+  os         = "debian"
+}
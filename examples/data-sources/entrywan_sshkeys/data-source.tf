@@ -0,0 +1,11 @@
+data "entrywan_sshkeys" "all" {}
+
+resource "entrywan_instance" "castula" {
+  hostname   = "castula"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = data.entrywan_sshkeys.all.sshkeys[*].id
+  os         = "debian"
+}
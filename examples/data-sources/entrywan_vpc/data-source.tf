@@ -0,0 +1,14 @@
+data "entrywan_vpc" "shared" {
+  name = "myvpc"
+}
+
+resource "entrywan_instance" "castula" {
+  hostname   = "castula"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = "debian"
+  vpcids     = [data.entrywan_vpc.shared.id]
+}
@@ -14,7 +14,6 @@ resource "entrywan_instance" "castula" {
   disk       = 20
   cpus       = 1
   ram        = 2
-  sshkey     = "mysshkey"
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
   os         = "debian"
-  depends_on = entrywan_sshkey.mysshkey
 }
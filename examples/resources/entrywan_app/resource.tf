@@ -4,5 +4,42 @@ resource "entrywan_app" "nginx" {
   image    = "nginx"
   port     = 80
   size     = 256
+  replicas = 3
   source   = "oci"
+
+  healthcheck {
+    path         = "/"
+    interval     = 10
+    grace_period = 30
+  }
+}
+
+resource "entrywan_app" "hello" {
+  name       = "my-hello-app"
+  location   = "us1"
+  repo       = "https://github.com/entrywan/hello"
+  repobranch = "main"
+  commit     = "3f2a9c1e8b7d6f5a4c3b2a1908f7e6d5c4b3a291"
+  port       = 8080
+  size       = 256
+  source     = "github"
+
+  redeploy_trigger = {
+    release = "2024-06-01"
+  }
+}
+
+resource "entrywan_app" "private" {
+  name     = "my-private-app"
+  location = "us1"
+  image    = "ghcr.io/myorg/myapp:v2"
+  port     = 8080
+  size     = 512
+  source   = "oci"
+
+  registry_credential {
+    server   = "ghcr.io"
+    username = "myorg-bot"
+    password = var.ghcr_token
+  }
 }
@@ -0,0 +1,17 @@
+resource "entrywan_app" "nginx" {
+  name     = "my-nginx-worker"
+  location = "us1"
+  image    = "nginx"
+  port     = 80
+  size     = 256
+  source   = "oci"
+}
+
+resource "entrywan_app_domain" "www" {
+  app_id   = entrywan_app.nginx.id
+  hostname = "www.example.com"
+}
+
+output "dns_records" {
+  value = entrywan_app_domain.www.dns_records
+}
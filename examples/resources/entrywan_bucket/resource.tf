@@ -0,0 +1,16 @@
+resource "entrywan_bucket" "uploads" {
+  name       = "my-app-uploads"
+  location   = "us1"
+  acl        = "private"
+  versioning = true
+
+  lifecycle_rule {
+    prefix                     = "tmp/"
+    expiration_days            = 7
+    noncurrent_expiration_days = 30
+  }
+}
+
+output "bucket_endpoint" {
+  value = entrywan_bucket.uploads.endpoint
+}
@@ -0,0 +1,20 @@
+resource "entrywan_bucket" "uploads" {
+  name     = "my-app-uploads"
+  location = "us1"
+}
+
+resource "entrywan_bucket_key" "app" {
+  bucket_id  = entrywan_bucket.uploads.id
+  name       = "my-app"
+  permission = "readwrite"
+  prefix     = "uploads/"
+}
+
+output "access_key_id" {
+  value = entrywan_bucket_key.app.access_key_id
+}
+
+output "secret_access_key" {
+  value     = entrywan_bucket_key.app.secret_access_key
+  sensitive = true
+}
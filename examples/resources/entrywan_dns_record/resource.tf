@@ -0,0 +1,33 @@
+resource "entrywan_dns_zone" "example" {
+  name = "example.com"
+}
+
+resource "entrywan_dns_record" "www" {
+  zone_id = entrywan_dns_zone.example.id
+  name    = "www"
+  type    = "A"
+  value   = entrywan_instance.web.ip4
+  ttl     = 300
+}
+
+resource "entrywan_dns_record" "api" {
+  zone_id = entrywan_dns_zone.example.id
+  name    = "api"
+  type    = "A"
+  value   = entrywan_loadbalancer.api.ip
+}
+
+resource "entrywan_dns_record" "mx" {
+  zone_id  = entrywan_dns_zone.example.id
+  name     = "@"
+  type     = "MX"
+  value    = "mail.example.com"
+  priority = 10
+}
+
+resource "entrywan_dns_record" "caa" {
+  zone_id = entrywan_dns_zone.example.id
+  name    = "@"
+  type    = "CAA"
+  value   = "0 issue \"letsencrypt.org\""
+}
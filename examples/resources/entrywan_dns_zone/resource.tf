@@ -0,0 +1,7 @@
+resource "entrywan_dns_zone" "example" {
+  name = "example.com"
+}
+
+output "nameservers" {
+  value = entrywan_dns_zone.example.nameservers
+}
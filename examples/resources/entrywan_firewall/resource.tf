@@ -4,10 +4,18 @@ resource "entrywan_firewall" "myfirewall" {
   rules {
     port     = "80"
     protocol = "tcp"
+    src      = "0.0.0.0/0"
   }
 
   rules {
     port     = "443"
     protocol = "tcp"
+    src      = "0.0.0.0/0"
+  }
+
+  rules {
+    port     = "22"
+    protocol = "tcp"
+    src      = "2001:db8::/32"
   }
 }
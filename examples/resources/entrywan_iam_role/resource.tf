@@ -0,0 +1,5 @@
+resource "entrywan_iam_role" "deployer" {
+  name        = "deployer"
+  description = "Deploys apps from CI"
+  scopes      = ["apps:write", "registries:write", "instances:read"]
+}
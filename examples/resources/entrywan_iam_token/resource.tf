@@ -0,0 +1,10 @@
+resource "entrywan_iam_token" "ci" {
+  name       = "github-actions"
+  scopes     = ["apps:write", "registries:write"]
+  expires_at = "2025-01-01T00:00:00Z"
+}
+
+output "ci_token" {
+  value     = entrywan_iam_token.ci.secret
+  sensitive = true
+}
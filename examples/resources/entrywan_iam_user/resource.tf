@@ -0,0 +1,9 @@
+resource "entrywan_iam_role" "readonly" {
+  name   = "readonly"
+  scopes = ["instances:read", "apps:read"]
+}
+
+resource "entrywan_iam_user" "alice" {
+  email    = "alice@example.com"
+  role_ids = [entrywan_iam_role.readonly.id]
+}
@@ -1,9 +1,20 @@
+resource "entrywan_sshkey" "alice" {
+  name = "alice"
+  pub  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBPdKY/JtRdXBoonecpczBwzGKSch8UIKGhLROjGLXBU alice@betelgeuse"
+}
+
 resource "entrywan_instance" "myinstance" {
-  hostname = "castula"
-  location = "us1"
-  disk     = 20
-  cpus     = 1
-  ram      = 2
-  sshkey   = "mysshkey"
-  os       = "debian"
+  hostname   = "castula"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [entrywan_sshkey.alice.id]
+  os         = "debian"
+  tags       = ["web", "prod"]
+
+  backup_policy {
+    schedule  = "daily"
+    retention = 14
+  }
 }
@@ -0,0 +1,21 @@
+resource "entrywan_project" "staging" {
+  name        = "staging"
+  description = "Staging environment"
+}
+
+resource "entrywan_vpc" "staging" {
+  name       = "staging"
+  prefix     = "10.20.0.0/16"
+  project_id = entrywan_project.staging.id
+}
+
+resource "entrywan_instance" "web" {
+  hostname   = "web-staging"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = "debian"
+  project_id = entrywan_project.staging.id
+}
@@ -0,0 +1,33 @@
+resource "entrywan_registry" "myregistry" {
+  namespace = "myorg"
+  location  = "us1"
+
+  retention {
+    keep_last     = 10
+    untagged_days = 7
+  }
+}
+
+resource "entrywan_app" "api" {
+  name     = "my-api"
+  location = "us1"
+  image    = "${entrywan_registry.myregistry.endpoint}/api:v1"
+  port     = 8080
+  size     = 256
+  source   = "oci"
+
+  registry_credential {
+    server   = entrywan_registry.myregistry.server
+    username = entrywan_registry.myregistry.pull_username
+    password = entrywan_registry.myregistry.pull_password
+  }
+}
+
+output "push_username" {
+  value = entrywan_registry.myregistry.push_username
+}
+
+output "push_password" {
+  value     = entrywan_registry.myregistry.push_password
+  sensitive = true
+}
@@ -0,0 +1,4 @@
+resource "entrywan_reserved_ip" "web" {
+  name     = "web"
+  location = "us1"
+}
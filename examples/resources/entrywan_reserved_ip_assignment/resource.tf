@@ -0,0 +1,19 @@
+resource "entrywan_reserved_ip" "web" {
+  name     = "web"
+  location = "us1"
+}
+
+resource "entrywan_instance" "web" {
+  hostname   = "web"
+  location   = "us1"
+  disk       = 20
+  cpus       = 1
+  ram        = 2
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = "debian"
+}
+
+resource "entrywan_reserved_ip_assignment" "web" {
+  reserved_ip_id = entrywan_reserved_ip.web.id
+  instance_id    = entrywan_instance.web.id
+}
@@ -0,0 +1,4 @@
+resource "entrywan_reverse_dns" "web" {
+  ip       = entrywan_instance.web.ip4
+  hostname = "www.example.com"
+}
@@ -0,0 +1,14 @@
+resource "entrywan_snapshot" "golden" {
+  name        = "golden-2024-06-01"
+  instance_id = entrywan_instance.builder.id
+}
+
+resource "entrywan_instance" "web" {
+  hostname    = "web"
+  location    = "us1"
+  disk        = 20
+  cpus        = 1
+  ram         = 2
+  sshkey_ids  = [entrywan_sshkey.mysshkey.id]
+  snapshot_id = entrywan_snapshot.golden.id
+}
@@ -0,0 +1,6 @@
+resource "entrywan_volume" "pgdata" {
+  name       = "pgdata"
+  location   = "us1"
+  size       = 100
+  filesystem = "xfs"
+}
@@ -0,0 +1,24 @@
+resource "entrywan_volume" "pgdata" {
+  name     = "pgdata"
+  location = "us1"
+  size     = 100
+}
+
+resource "entrywan_instance" "db" {
+  hostname   = "db"
+  location   = "us1"
+  disk       = 20
+  cpus       = 2
+  ram        = 4
+  sshkey_ids = [entrywan_sshkey.mysshkey.id]
+  os         = "debian"
+}
+
+resource "entrywan_volume_attachment" "pgdata" {
+  volume_id   = entrywan_volume.pgdata.id
+  instance_id = entrywan_instance.db.id
+}
+
+output "pgdata_device" {
+  value = entrywan_volume_attachment.pgdata.device
+}
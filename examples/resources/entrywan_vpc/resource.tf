@@ -1,6 +1,7 @@
 resource "entrywan_vpc" "vpc" {
-  name   = "myvpc"
-  prefix = "192.168.5.0/24"
+  name    = "myvpc"
+  prefix  = "192.168.5.0/24"
+  prefix6 = "fd00:5::/64"
   members {
     ip4public = "38.22.213.9"
   }